@@ -0,0 +1,108 @@
+package destiny2
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// AggregateReader reads contracts from the aggregate JSON manifest, which contains every contract for a
+// language/locale in a single file. Each aggregate file is downloaded once and split into contracts, so
+// fulfilling many contracts costs a single request per language/locale.
+// Contracts must be fulfilled with UseAggregateManifest(true) when using this reader.
+type AggregateReader struct {
+	mu sync.Mutex
+	// aggregates are the contracts in each downloaded aggregate file, by path and then contract name.
+	aggregates map[string]map[string]json.RawMessage
+}
+
+// ReadContract reads a contract from the aggregate JSON manifest at path.
+func (r *AggregateReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	if useMobile {
+		return nil, fmt.Errorf("%q cannot be read from the mobile manifest by an AggregateReader", contract.Name())
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.aggregates == nil {
+		r.aggregates = map[string]map[string]json.RawMessage{}
+	}
+
+	contracts, ok := r.aggregates[path]
+	if !ok {
+		var err error
+		contracts, err = downloadAggregate(path)
+		if err != nil {
+			return nil, err
+		}
+		r.aggregates[path] = contracts
+	}
+
+	data, ok := contracts[contract.Name()]
+	if !ok {
+		return nil, fmt.Errorf("%q is not in the aggregate manifest %q", contract.Name(), path)
+	}
+	return data, nil
+}
+
+func (r *AggregateReader) readsNetwork() bool {
+	return true
+}
+
+// Close releases all downloaded aggregate files.
+func (r *AggregateReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.aggregates = nil
+	return nil
+}
+
+func downloadAggregate(path string) (map[string]json.RawMessage, error) {
+	resp, err := fetch(path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	contracts, err := splitAggregate(resp.Body)
+	if err != nil {
+		return nil, VerificationError{Path: path, Failure: FailedJSON, Detail: err.Error(), StatusCode: resp.StatusCode}
+	}
+	return contracts, nil
+}
+
+// splitAggregate splits an aggregate manifest, defined as {"definition": {...}}, into the JSON for each contract.
+// The aggregate is decoded as a stream so only one copy of each contract is held in memory.
+func splitAggregate(r io.Reader) (map[string]json.RawMessage, error) {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('{') {
+		return nil, fmt.Errorf("aggregate manifest should be an object, got %v", tok)
+	}
+
+	contracts := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("aggregate manifest has a non-string key %v", tok)
+		}
+
+		var data json.RawMessage
+		if err := dec.Decode(&data); err != nil {
+			return nil, fmt.Errorf("decoding %q in aggregate manifest: %v", name, err)
+		}
+		contracts[name] = data
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
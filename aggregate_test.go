@@ -0,0 +1,48 @@
+package destiny2
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSplitAggregate(t *testing.T) {
+	const aggregate = `{
+		"DestinyGenderDefinition": {"0": {"hash": 0, "displayProperties": {"name": "Masculine"}}},
+		"DestinyLoreDefinition": {"1": {"hash": 1, "subtitle": "A subtitle"}}
+	}`
+
+	contracts, err := splitAggregate(strings.NewReader(aggregate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contracts) != 2 {
+		t.Errorf("got %d contracts, want 2", len(contracts))
+	}
+
+	// Seed the reader so it doesn't download the aggregate manifest.
+	reader := &AggregateReader{aggregates: map[string]map[string]json.RawMessage{"/aggregate-en.json": contracts}}
+	manifest := &Manifest{
+		aggregateContracts: map[language.Tag]string{language.English: "/aggregate-en.json"},
+		contracts:          map[language.Tag]map[string]string{language.English: {}},
+		contractReader:     reader,
+	}
+
+	var lore LoreDefinition
+	if err := manifest.FulfillContract(&lore, UseAggregateManifest(true)); err != nil {
+		t.Fatal(err)
+	}
+	if got := lore[1].Subtitle; got != "A subtitle" {
+		t.Errorf("lore subtitle: got %q, want %q", got, "A subtitle")
+	}
+
+	if err := manifest.FulfillContract(new(VendorDefinition), UseAggregateManifest(true)); err == nil {
+		t.Error("FulfillContract of a contract missing from the aggregate manifest should fail")
+	}
+
+	if _, err := splitAggregate(strings.NewReader(`[]`)); err == nil {
+		t.Error("splitAggregate of a non-object should fail")
+	}
+}
@@ -0,0 +1,332 @@
+package destiny2
+
+import (
+	"bufio"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// ContractKey identifies a specific version of a contract in a language/locale.
+type ContractKey struct {
+	// Version is the version of the manifest containing the contract.
+	Version string
+	// Locale is the language/locale of the contract.
+	Locale language.Tag
+	// Path is the path to the contract in the Bungie.net API.
+	Path string
+	// Mobile is true if the contract is read from the mobile manifest.
+	Mobile bool
+	// Aggregate is true if the contract is read from the aggregate JSON manifest.
+	Aggregate bool
+}
+
+// ContractLoader is an optional interface implemented by a ContractReader that can populate a typed
+// contract directly rather than returning JSON. Manifest.FulfillContract prefers LoadContract when available,
+// including from a ContractLoader within the FallbackReaders and InstrumentedReaders of this package.
+type ContractLoader interface {
+	// LoadContract populates contract with the contract identified by key.
+	LoadContract(contract Contract, key ContractKey) error
+}
+
+const cacheFormatVersion = 2
+
+// cacheHeader is written before every cached contract to identify its contents.
+type cacheHeader struct {
+	FormatVersion int
+	Name          string
+	Version       string
+	Locale        string
+	Mobile        bool
+	Aggregate     bool
+}
+
+// CacheError represents a cached contract that doesn't match the requested contract.
+type CacheError struct {
+	field, want, got string
+}
+
+func (e CacheError) Error() string {
+	return fmt.Sprintf("cached contract has %s %q, want %q", e.field, e.got, e.want)
+}
+
+// WriteContractCache writes a fulfilled contract to w in a compact binary format tagged with the manifest version and locale.
+func WriteContractCache(w io.Writer, key ContractKey, contract Contract) error {
+	enc := gob.NewEncoder(w)
+	header := cacheHeader{
+		FormatVersion: cacheFormatVersion,
+		Name:          contract.Name(),
+		Version:       key.Version,
+		Locale:        key.Locale.String(),
+		Mobile:        key.Mobile,
+		Aggregate:     key.Aggregate,
+	}
+	if err := enc.Encode(header); err != nil {
+		return err
+	}
+	return enc.Encode(contract)
+}
+
+// ReadContractCache populates contract from a cache written by WriteContractCache.
+// A CacheError is returned if the cache is for a different contract, manifest version, locale or kind of manifest
+// file: mobile, aggregate or per-contract.
+func ReadContractCache(r io.Reader, key ContractKey, contract Contract) error {
+	dec := gob.NewDecoder(r)
+	var header cacheHeader
+	if err := dec.Decode(&header); err != nil {
+		return err
+	}
+
+	switch {
+	case header.FormatVersion != cacheFormatVersion:
+		return CacheError{"format version", fmt.Sprint(cacheFormatVersion), fmt.Sprint(header.FormatVersion)}
+	case header.Name != contract.Name():
+		return CacheError{"name", contract.Name(), header.Name}
+	case header.Version != key.Version:
+		return CacheError{"version", key.Version, header.Version}
+	case header.Locale != key.Locale.String():
+		return CacheError{"locale", key.Locale.String(), header.Locale}
+	case header.Mobile != key.Mobile:
+		return CacheError{"mobile", fmt.Sprint(key.Mobile), fmt.Sprint(header.Mobile)}
+	case header.Aggregate != key.Aggregate:
+		return CacheError{"aggregate", fmt.Sprint(key.Aggregate), fmt.Sprint(header.Aggregate)}
+	}
+	return dec.Decode(contract)
+}
+
+// BinaryCacheReader reads contracts from a directory of binary contract caches.
+// Contracts missing from the cache are read from Source and then cached.
+type BinaryCacheReader struct {
+	// Dir is the directory containing cached contracts.
+	Dir string
+	// Source is the ContractReader used when a contract isn't cached.
+	// If Source is nil, only cached contracts can be read.
+	Source ContractReader
+}
+
+// ReadContract reads the JSON for a contract from Source.
+func (r *BinaryCacheReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	data, _, err := r.readContractCached(contract, path, useMobile)
+	return data, err
+}
+
+// LoadContract populates contract from the cache, filling the cache from Source if necessary.
+func (r *BinaryCacheReader) LoadContract(contract Contract, key ContractKey) error {
+	_, err := r.loadContractCached(contract, key)
+	return err
+}
+
+func (r *BinaryCacheReader) readContractCached(contract Contract, path string, useMobile bool) ([]byte, bool, error) {
+	if r.Source == nil {
+		return nil, false, fmt.Errorf("%q is not cached and there is no source to read it from", contract.Name())
+	}
+	return readContractFrom(r.Source, contract, path, useMobile)
+}
+
+// loadContractCached reports a hit if the contract was in the cache or in a cache within Source.
+func (r *BinaryCacheReader) loadContractCached(contract Contract, key ContractKey) (bool, error) {
+	path := filepath.Join(r.Dir, cacheFileName(contract, key))
+	err := r.loadCached(path, contract, key)
+	if err == nil || r.Source == nil {
+		return err == nil, err
+	}
+
+	data, hit, err := readContractFrom(r.Source, contract, key.Path, key.Mobile)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, contract); err != nil {
+		return false, err
+	}
+	return hit, r.save(path, contract, key)
+}
+
+func (r *BinaryCacheReader) loadCached(path string, contract Contract, key ContractKey) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return ReadContractCache(bufio.NewReader(f), key, contract)
+}
+
+func (r *BinaryCacheReader) save(path string, contract Contract, key ContractKey) error {
+	return writeFileAtomic(path, func(w io.Writer) error {
+		bw := bufio.NewWriter(w)
+		if err := WriteContractCache(bw, key, contract); err != nil {
+			return err
+		}
+		return bw.Flush()
+	})
+}
+
+// writeFileAtomic creates path with the data written by write, creating its directory if necessary.
+// The data is written to a temporary file that is then moved into place, so readers never see a partial file.
+func writeFileAtomic(path string, write func(io.Writer) error) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// writeBytes returns a write function for writeFileAtomic that writes data.
+func writeBytes(data []byte) func(io.Writer) error {
+	return func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	}
+}
+
+// Close closes Source.
+func (r *BinaryCacheReader) Close() error {
+	if r.Source == nil {
+		return nil
+	}
+	return r.Source.Close()
+}
+
+// cacheFileName is the name of the file containing a cached contract.
+func cacheFileName(contract Contract, key ContractKey) string {
+	kind := ""
+	if key.Mobile {
+		kind = "-mobile"
+	} else if key.Aggregate {
+		kind = "-aggregate"
+	}
+	return fmt.Sprintf("%s-%s-%s%s.gob", contract.Name(), key.Locale, fileSafeVersion(key.Version), kind)
+}
+
+// fileSafeVersion replaces the characters in a manifest version that can't be used in a file name.
+func fileSafeVersion(version string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' {
+			return '_'
+		}
+		return r
+	}, version)
+}
+
+// ManifestSource is a manifest contracts are listed and fulfilled from, typically a *Manifest.
+// It is the manifest behind a ContractCache and the servers and tools built on one.
+type ManifestSource interface {
+	// Version is the version of the manifest.
+	Version() string
+	// Contracts returns the names of the contracts in the manifest that NewContract can create.
+	Contracts() []string
+	// FulfillContract fulfills definition with opts.
+	FulfillContract(definition Contract, opts ...FulfillmentOption) error
+}
+
+// contractCacheKey identifies a fulfilled contract in a ContractCache.
+type contractCacheKey struct {
+	name, locale string
+}
+
+// ContractCache keeps fulfilled contracts in memory so they can be shared, such as by the requests to a server.
+// Each contract is fulfilled once per locale and kept until the version of the manifest changes.
+// A ContractCache is safe for concurrent use. Contracts are fulfilled one at a time, since ContractReaders
+// aren't safe for concurrent use, but cached contracts are served while another is being fulfilled.
+type ContractCache struct {
+	manifest ManifestSource
+	opts     []FulfillmentOption
+
+	// fulfill serializes calls to FulfillContract.
+	fulfill sync.Mutex
+
+	// mu guards the cached contracts.
+	mu        sync.RWMutex
+	version   string
+	contracts map[contractCacheKey]Contract
+}
+
+// NewContractCache returns an empty ContractCache for contracts fulfilled from manifest with opts,
+// in addition to the locale of each contract.
+func NewContractCache(manifest ManifestSource, opts ...FulfillmentOption) *ContractCache {
+	return &ContractCache{manifest: manifest, opts: opts, contracts: map[contractCacheKey]Contract{}}
+}
+
+// Version returns the version of the manifest contracts are fulfilled from.
+func (c *ContractCache) Version() string {
+	return c.manifest.Version()
+}
+
+// Contract returns the fulfilled contract with a given name in a Bungie.net locale, such as "en", fulfilling it
+// if it isn't cached. A ContractError is returned for an unknown name and a LocaleError for an unsupported locale;
+// any other error is from fulfilling the contract.
+func (c *ContractCache) Contract(name, locale string) (Contract, error) {
+	tag, err := LocaleTag(locale)
+	if err != nil {
+		return nil, err
+	}
+	key := contractCacheKey{name, BungieLocale(tag)}
+	if contract, ok := c.cached(key); ok {
+		return contract, nil
+	}
+
+	contract, err := NewContract(name)
+	if err != nil {
+		return nil, err
+	}
+
+	c.fulfill.Lock()
+	defer c.fulfill.Unlock()
+	// The contract may have been fulfilled while waiting for the lock.
+	if cached, ok := c.cached(key); ok {
+		return cached, nil
+	}
+	version := c.manifest.Version()
+	opts := append([]FulfillmentOption{WithLocale(key.locale)}, c.opts...)
+	if err := c.manifest.FulfillContract(contract, opts...); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// A contract fulfilled during an update may be from either version, so it is only cached if there wasn't one.
+	if c.version == version && c.manifest.Version() == version {
+		c.contracts[key] = contract
+	}
+	return contract, nil
+}
+
+// cached returns the contract cached with key, dropping every cached contract if the manifest has a new version.
+func (c *ContractCache) cached(key contractCacheKey) (Contract, bool) {
+	version := c.manifest.Version()
+	c.mu.RLock()
+	contract, ok := c.contracts[key]
+	current := c.version == version
+	c.mu.RUnlock()
+	if current {
+		return contract, ok
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.version != version {
+		c.version = version
+		c.contracts = map[contractCacheKey]Contract{}
+	}
+	return nil, false
+}
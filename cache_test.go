@@ -0,0 +1,249 @@
+package destiny2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/text/language"
+)
+
+// countingReader counts how many times each contract is read.
+type countingReader struct {
+	ContractReader
+	reads int
+}
+
+func (r *countingReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	r.reads++
+	return r.ContractReader.ReadContract(contract, path, useMobile)
+}
+
+func TestBinaryCacheReader(t *testing.T) {
+	genders := GenderDefinition{}.Name()
+	source := &countingReader{ContractReader: pathReader{
+		"/en": `{"0": {"hash": 0, "genderType": 0, "displayProperties": {"name": "Masculine"}}, "1": {"hash": 1, "genderType": 1, "displayProperties": {"name": "Feminine"}}}`,
+	}}
+	reader := &BinaryCacheReader{Dir: t.TempDir(), Source: source}
+	manifest := &Manifest{
+		version:        "1.0",
+		contracts:      map[language.Tag]map[string]string{language.English: {genders: "/en"}},
+		contractReader: reader,
+	}
+
+	var first, second GenderDefinition
+	if err := manifest.FulfillContract(&first); err != nil {
+		t.Fatal(err)
+	}
+	if err := manifest.FulfillContract(&second); err != nil {
+		t.Fatal(err)
+	}
+
+	if source.reads != 1 {
+		t.Errorf("source should only be read once: got %d reads", source.reads)
+	}
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("cached contract differs: %s", diff)
+	}
+
+	// The aggregate manifest should not use the cache of the per-contract manifest.
+	manifest.aggregateContracts = map[language.Tag]string{language.English: "/en"}
+	var aggregate GenderDefinition
+	if err := manifest.FulfillContract(&aggregate, UseAggregateManifest(true)); err != nil {
+		t.Fatal(err)
+	}
+	if source.reads != 2 {
+		t.Errorf("source should be read for the aggregate manifest: got %d reads", source.reads)
+	}
+
+	// A new manifest version should not use the old cache.
+	manifest.version = "2.0"
+	var third GenderDefinition
+	if err := manifest.FulfillContract(&third); err != nil {
+		t.Fatal(err)
+	}
+	if source.reads != 3 {
+		t.Errorf("source should be read for a new version: got %d reads", source.reads)
+	}
+
+	// Without a source, only cached contracts are available.
+	manifest.contractReader = &BinaryCacheReader{Dir: reader.Dir}
+	var fourth GenderDefinition
+	if err := manifest.FulfillContract(&fourth); err != nil {
+		t.Fatal(err)
+	}
+	manifest.version = "3.0"
+	if err := manifest.FulfillContract(&fourth); err == nil {
+		t.Error("FulfillContract without a cached contract or source should fail")
+	}
+}
+
+func TestReadContractCache(t *testing.T) {
+	key := ContractKey{Version: "1.0", Locale: language.German}
+	contract := LoreDefinition{1: {Subtitle: "Untertitel", EntityMetadata: EntityMetadata{Hash: 1}}}
+
+	var buf bytes.Buffer
+	if err := WriteContractCache(&buf, key, &contract); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	var got LoreDefinition
+	if err := ReadContractCache(bytes.NewReader(data), key, &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(contract, got); diff != "" {
+		t.Errorf("ReadContractCache differs: %s", diff)
+	}
+
+	var cacheErr CacheError
+	french := ContractKey{Version: "1.0", Locale: language.French}
+	if err := ReadContractCache(bytes.NewReader(data), french, &got); !errors.As(err, &cacheErr) {
+		t.Errorf("ReadContractCache with a different locale: got %v, want CacheError", err)
+	}
+	if err := ReadContractCache(bytes.NewReader(data), key, &GenderDefinition{}); !errors.As(err, &cacheErr) {
+		t.Errorf("ReadContractCache with a different contract: got %v, want CacheError", err)
+	}
+	mobile := ContractKey{Version: "1.0", Locale: language.German, Mobile: true}
+	if err := ReadContractCache(bytes.NewReader(data), mobile, &got); !errors.As(err, &cacheErr) {
+		t.Errorf("ReadContractCache from the mobile manifest: got %v, want CacheError", err)
+	}
+	aggregate := ContractKey{Version: "1.0", Locale: language.German, Aggregate: true}
+	if err := ReadContractCache(bytes.NewReader(data), aggregate, &got); !errors.As(err, &cacheErr) {
+		t.Errorf("ReadContractCache from the aggregate manifest: got %v, want CacheError", err)
+	}
+}
+
+// benchmarkItemsJSON returns an InventoryItemDefinition with n items shaped like weapons, as JSON shaped like
+// Bungie.net's, where enums are numbers rather than the names they marshal to.
+func benchmarkItemsJSON(n int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		var stats, investment, sockets []string
+		for s := 0; s < 8; s++ {
+			stats = append(stats, fmt.Sprintf(`"%d": {"statHash": %d, "value": 50, "minimum": 0, "maximum": 100, "displayMaximum": 100}`, s, s))
+			investment = append(investment, fmt.Sprintf(`{"statTypeHash": %d, "value": 40, "isConditionallyActive": false}`, s))
+		}
+		for s := 0; s < 6; s++ {
+			sockets = append(sockets, fmt.Sprintf(`{"socketTypeHash": %d, "singleInitialItemHash": 0, "reusablePlugItems": [], "preventInitializationOnVendorPurchase": false, "hidePerksInItemTooltip": false, "plugSources": %d, "randomizedPlugSetHash": %d, "defaultVisible": true}`, s, SocketPlug_InventorySourced|SocketPlug_ReusablePlugItems, s))
+		}
+		fmt.Fprintf(&buf, `"%d": {
+			"displayProperties": {"description": "A weapon of some renown.", "name": "Item %d", "icon": "/common/destiny2_content/icons/item.jpg", "hasIcon": true},
+			"itemType": %d, "itemSubType": %d, "classType": %d, "defaultDamageType": %d,
+			"inventory": {"maxStackSize": 1, "bucketTypeHash": 1498876634, "tierTypeHash": 4008398120, "tierTypeName": "Legendary", "tierType": %d},
+			"stats": {"disablePrimaryStatDisplay": false, "statGroupHash": 1, "stats": {%s}, "hasDisplayableStats": true, "primaryBaseStatHash": 1480404414},
+			"investmentStats": [%s],
+			"sockets": {"detail": "", "socketEntries": [%s]},
+			"itemCategoryHashes": [1, 2, 3],
+			"hash": %d, "index": %d, "redacted": false, "blacklisted": false
+		}`, i, i, Item_Weapon, SubType_ScoutRifle, Class_Unknown, DamageType_Kinetic, ItemTier_Superior,
+			strings.Join(stats, ", "), strings.Join(investment, ", "), strings.Join(sockets, ", "), i, i)
+	}
+	buf.WriteString("}")
+	return buf.Bytes()
+}
+
+func BenchmarkContractDecode(b *testing.B) {
+	jsonData := benchmarkItemsJSON(2000)
+	key := ContractKey{Version: "1.0", Locale: language.English}
+
+	var items InventoryItemDefinition
+	if err := json.Unmarshal(jsonData, &items); err != nil {
+		b.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteContractCache(&buf, key, &items); err != nil {
+		b.Fatal(err)
+	}
+	cacheData := buf.Bytes()
+
+	b.Run("JSON", func(b *testing.B) {
+		b.SetBytes(int64(len(jsonData)))
+		for i := 0; i < b.N; i++ {
+			var got InventoryItemDefinition
+			if err := json.Unmarshal(jsonData, &got); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("BinaryCache", func(b *testing.B) {
+		b.SetBytes(int64(len(cacheData)))
+		for i := 0; i < b.N; i++ {
+			var got InventoryItemDefinition
+			if err := ReadContractCache(bytes.NewReader(cacheData), key, &got); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// countingFulfiller fulfills contracts from JSON and counts the contracts fulfilled.
+type countingFulfiller struct {
+	version   string
+	contracts map[string]string
+	fulfilled int
+}
+
+func (f *countingFulfiller) Version() string {
+	return f.version
+}
+
+func (f *countingFulfiller) Contracts() []string {
+	var names []string
+	for name := range f.contracts {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (f *countingFulfiller) FulfillContract(definition Contract, opts ...FulfillmentOption) error {
+	f.fulfilled++
+	return definition.Unmarshal([]byte(f.contracts[definition.Name()]))
+}
+
+func TestContractCache(t *testing.T) {
+	lore := LoreDefinition{}.Name()
+	manifest := &countingFulfiller{version: "v1", contracts: map[string]string{lore: `{"1": {"hash": 1, "subtitle": "old"}}`}}
+	cache := NewContractCache(manifest)
+
+	subtitle := func(locale string) string {
+		contract, err := cache.Contract(lore, locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return (*contract.(*LoreDefinition))[1].Subtitle
+	}
+
+	if got := subtitle("en"); got != "old" {
+		t.Errorf("Contract: got subtitle %q, want %q", got, "old")
+	}
+	subtitle("EN")
+	if manifest.fulfilled != 1 {
+		t.Errorf("Contract fulfilled %d times, want 1", manifest.fulfilled)
+	}
+	subtitle("de")
+	if manifest.fulfilled != 2 {
+		t.Errorf("Contract in another locale fulfilled %d contracts, want 2", manifest.fulfilled)
+	}
+
+	manifest.version = "v2"
+	manifest.contracts[lore] = `{"1": {"hash": 1, "subtitle": "new"}}`
+	if got := subtitle("en"); got != "new" {
+		t.Errorf("Contract after update: got subtitle %q, want %q", got, "new")
+	}
+
+	if _, err := cache.Contract("DestinyNotADefinition", "en"); !errors.As(err, &ContractError{}) {
+		t.Errorf("Contract with an unknown name: got %v, want ContractError", err)
+	}
+	if _, err := cache.Contract(lore, "tlh"); !errors.As(err, &LocaleError{}) {
+		t.Errorf("Contract with an unsupported locale: got %v, want LocaleError", err)
+	}
+}
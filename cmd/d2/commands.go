@@ -0,0 +1,345 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/paranoiacblack/destiny2"
+)
+
+// usageError is an error in the arguments to a command.
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string {
+	return e.msg
+}
+
+// cli runs commands against a manifest.
+type cli struct {
+	out io.Writer
+	// open returns the manifest at a stored version, or the current manifest if version is "".
+	open func(version string) (destiny2.ManifestSource, error)
+	// opts are used when fulfilling every contract.
+	opts []destiny2.FulfillmentOption
+
+	format   string
+	contract string
+	limit    int
+}
+
+func (c *cli) run(command string, args []string) error {
+	commands := map[string]struct {
+		args int
+		run  func(m destiny2.ManifestSource, args []string) error
+	}{
+		"version":   {0, c.version},
+		"contracts": {0, c.contracts},
+		"get":       {2, c.get},
+		"find-hash": {1, c.findHash},
+		"search":    {1, c.search},
+		"dump":      {1, c.dump},
+	}
+	if command == "diff" {
+		if len(args) != 2 {
+			return usageError{"diff requires 2 arguments: <v1> <v2>"}
+		}
+		return c.diff(args[0], args[1])
+	}
+
+	cmd, ok := commands[command]
+	if !ok {
+		return usageError{fmt.Sprintf("%q is not a known command", command)}
+	}
+	if len(args) != cmd.args {
+		return usageError{fmt.Sprintf("%s requires %d arguments, got %d", command, cmd.args, len(args))}
+	}
+	m, err := c.open("")
+	if err != nil {
+		return fmt.Errorf("loading manifest: %v", err)
+	}
+	return cmd.run(m, args)
+}
+
+func (c *cli) version(m destiny2.ManifestSource, args []string) error {
+	_, err := fmt.Fprintln(c.out, m.Version())
+	return err
+}
+
+func (c *cli) contracts(m destiny2.ManifestSource, args []string) error {
+	for _, name := range m.Contracts() {
+		if _, err := fmt.Fprintln(c.out, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// get prints the entity with a hash in a contract.
+func (c *cli) get(m destiny2.ManifestSource, args []string) error {
+	hash, err := parseHash(args[1])
+	if err != nil {
+		return err
+	}
+	contract, err := c.fulfill(m, args[0])
+	if err != nil {
+		return err
+	}
+
+	entity, ok := destiny2.FindEntity(contract, hash)
+	if !ok {
+		return fmt.Errorf("%s has no entity with hash %d", contract.Name(), hash)
+	}
+	enc := json.NewEncoder(c.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entity)
+}
+
+// findHash prints every contract with an entity with a hash, since hashes are only unique within a contract.
+func (c *cli) findHash(m destiny2.ManifestSource, args []string) error {
+	hash, err := parseHash(args[0])
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, name := range m.Contracts() {
+		contract, err := c.fulfill(m, name)
+		if err != nil {
+			return err
+		}
+		if entity, ok := destiny2.FindEntity(contract, hash); ok {
+			found = true
+			fmt.Fprintf(c.out, "%s\t%d\t%s\n", name, hash, entityName(entity))
+		}
+	}
+	if !found {
+		return fmt.Errorf("no entity has hash %d", hash)
+	}
+	return nil
+}
+
+// search prints entities whose name contains a query, ignoring case.
+func (c *cli) search(m destiny2.ManifestSource, args []string) error {
+	names := m.Contracts()
+	if c.contract != "" {
+		names = []string{c.contract}
+	}
+
+	n := 0
+	for _, name := range names {
+		contract, err := c.fulfill(m, name)
+		if err != nil {
+			return err
+		}
+		for _, hash := range destiny2.SearchContract(contract, args[0]) {
+			if c.limit > 0 && n >= c.limit {
+				return nil
+			}
+			entity, _ := destiny2.FindEntity(contract, hash)
+			fmt.Fprintf(c.out, "%s\t%d\t%s\n", contract.Name(), hash, entityName(entity))
+			n++
+		}
+	}
+	return nil
+}
+
+// csvHeader is the header of a contract dumped as CSV. The entity column holds the whole entity as JSON.
+var csvHeader = []string{"hash", "name", "description", "icon", "redacted", "entity"}
+
+// dump prints every entity in a contract, ordered by hash.
+func (c *cli) dump(m destiny2.ManifestSource, args []string) error {
+	contract, err := c.fulfill(m, args[0])
+	if err != nil {
+		return err
+	}
+
+	switch c.format {
+	case "json":
+		enc := json.NewEncoder(c.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(contract)
+	case "jsonl":
+		enc := json.NewEncoder(c.out)
+		for _, hash := range entityHashes(contract) {
+			if err := enc.Encode(contract.Entity(hash)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "csv":
+		w := csv.NewWriter(c.out)
+		w.Write(csvHeader)
+		for _, hash := range entityHashes(contract) {
+			entity := contract.Entity(hash)
+			data, err := json.Marshal(entity)
+			if err != nil {
+				return err
+			}
+			display, _ := destiny2.EntityDisplayProperties(entity)
+			redacted := reflect.ValueOf(entity).FieldByName("Redacted").Bool()
+			w.Write([]string{
+				strconv.FormatUint(uint64(hash), 10),
+				display.Name,
+				display.Description,
+				display.Icon,
+				strconv.FormatBool(redacted),
+				string(data),
+			})
+		}
+		w.Flush()
+		return w.Error()
+	}
+	return usageError{fmt.Sprintf("%q is not a known format; use json, jsonl or csv", c.format)}
+}
+
+// diff prints the entities added (+), removed (-) and changed (~) between two stored versions.
+// Entity indexes are ignored, since they change whenever entities are added to a contract.
+func (c *cli) diff(v1, v2 string) error {
+	older, err := c.open(v1)
+	if err != nil {
+		return fmt.Errorf("loading manifest %s: %v", v1, err)
+	}
+	newer, err := c.open(v2)
+	if err != nil {
+		return fmt.Errorf("loading manifest %s: %v", v2, err)
+	}
+
+	names := []string{c.contract}
+	if c.contract == "" {
+		names = unionContracts(older.Contracts(), newer.Contracts())
+	}
+	for _, name := range names {
+		before, err := c.fulfillIfPresent(older, name)
+		if err != nil {
+			return fmt.Errorf("%s: %v", v1, err)
+		}
+		after, err := c.fulfillIfPresent(newer, name)
+		if err != nil {
+			return fmt.Errorf("%s: %v", v2, err)
+		}
+		for _, change := range diffContracts(before, after) {
+			fmt.Fprintf(c.out, "%c\t%s\t%d\t%s\n", change.op, contractName(name), change.hash, change.name)
+		}
+	}
+	return nil
+}
+
+// change is an entity that differs between two versions of a contract.
+type change struct {
+	op   rune
+	hash uint32
+	name string
+}
+
+// diffContracts returns the changes from before to after, ordered by hash. Either contract may be nil if it is
+// missing from its version.
+func diffContracts(before, after destiny2.Contract) []change {
+	older, newer := destiny2.ContractEntities(before), destiny2.ContractEntities(after)
+	hashes := map[uint32]bool{}
+	for _, entities := range []map[uint32]interface{}{older, newer} {
+		for hash := range entities {
+			hashes[hash] = true
+		}
+	}
+
+	var changes []change
+	for hash := range hashes {
+		olderEntity, inOlder := older[hash]
+		newerEntity, inNewer := newer[hash]
+		switch {
+		case !inOlder:
+			changes = append(changes, change{'+', hash, entityName(newerEntity)})
+		case !inNewer:
+			changes = append(changes, change{'-', hash, entityName(olderEntity)})
+		case !reflect.DeepEqual(withoutIndex(olderEntity), withoutIndex(newerEntity)):
+			changes = append(changes, change{'~', hash, entityName(newerEntity)})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].hash < changes[j].hash })
+	return changes
+}
+
+// fulfill returns a fulfilled contract by name.
+func (c *cli) fulfill(m destiny2.ManifestSource, name string) (destiny2.Contract, error) {
+	contract, err := destiny2.NewContract(contractName(name))
+	if err != nil {
+		return nil, usageError{err.Error()}
+	}
+	if err := m.FulfillContract(contract, c.opts...); err != nil {
+		return nil, fmt.Errorf("fulfilling %s: %v", contract.Name(), err)
+	}
+	return contract, nil
+}
+
+// fulfillIfPresent returns a fulfilled contract by name, or nil if m doesn't have the contract.
+func (c *cli) fulfillIfPresent(m destiny2.ManifestSource, name string) (destiny2.Contract, error) {
+	name = contractName(name)
+	for _, present := range m.Contracts() {
+		if present == name {
+			return c.fulfill(m, name)
+		}
+	}
+	return nil, nil
+}
+
+// contractName returns the full name of a contract, which may be given without its Destiny prefix and Definition suffix.
+func contractName(name string) string {
+	if strings.HasPrefix(name, "Destiny") && strings.HasSuffix(name, "Definition") {
+		return name
+	}
+	return "Destiny" + name + "Definition"
+}
+
+func unionContracts(a, b []string) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, name := range append(a, b...) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// entityHashes returns the hashes of every entity in a fulfilled contract, in ascending order.
+func entityHashes(contract destiny2.Contract) []uint32 {
+	keys := reflect.ValueOf(contract).Elem().MapKeys()
+	hashes := make([]uint32, len(keys))
+	for i, key := range keys {
+		hashes[i] = uint32(key.Uint())
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	return hashes
+}
+
+func entityName(entity interface{}) string {
+	display, _ := destiny2.EntityDisplayProperties(entity)
+	return display.Name
+}
+
+// withoutIndex returns a copy of an entity with its index cleared.
+func withoutIndex(entity interface{}) interface{} {
+	v := reflect.New(reflect.TypeOf(entity)).Elem()
+	v.Set(reflect.ValueOf(entity))
+	if index := v.FieldByName("Index"); index.IsValid() {
+		index.Set(reflect.Zero(index.Type()))
+	}
+	return v.Interface()
+}
+
+func parseHash(s string) (uint32, error) {
+	hash, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, usageError{fmt.Sprintf("%q is not a valid hash", s)}
+	}
+	return uint32(hash), nil
+}
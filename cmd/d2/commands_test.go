@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/paranoiacblack/destiny2"
+)
+
+// fakeManifest serves contracts from JSON.
+type fakeManifest struct {
+	version   string
+	contracts map[string]string
+}
+
+func (m *fakeManifest) Version() string {
+	return m.version
+}
+
+func (m *fakeManifest) Contracts() []string {
+	var names []string
+	for name := range m.contracts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *fakeManifest) FulfillContract(definition destiny2.Contract, opts ...destiny2.FulfillmentOption) error {
+	return definition.Unmarshal([]byte(m.contracts[definition.Name()]))
+}
+
+var versions = map[string]*fakeManifest{
+	"v1": {
+		version: "v1",
+		contracts: map[string]string{
+			"DestinyLoreDefinition": `{
+				"1": {"hash": 1, "index": 0, "displayProperties": {"name": "Ace in the Hole", "description": "A gambler's tale"}},
+				"2": {"hash": 2, "index": 1, "displayProperties": {"name": "Marasenna"}},
+				"3": {"hash": 3, "index": 2, "displayProperties": {"name": "Unveiling"}}
+			}`,
+			"DestinyStatDefinition": `{"1": {"hash": 1, "displayProperties": {"name": "Mobility"}}}`,
+		},
+	},
+	"v2": {
+		version: "v2",
+		contracts: map[string]string{
+			"DestinyLoreDefinition": `{
+				"1": {"hash": 1, "index": 5, "displayProperties": {"name": "Ace in the Hole", "description": "A gambler's tale"}},
+				"2": {"hash": 2, "index": 6, "displayProperties": {"name": "Marasenna, Revised"}},
+				"4": {"hash": 4, "index": 7, "displayProperties": {"name": "The Witch Queen"}}
+			}`,
+
+			// Progressions have their own display properties, with the name embedded.
+			"DestinyProgressionDefinition": `{"5": {"hash": 5, "displayProperties": {"name": "Valor Rank", "displayUnitsName": "points"}}}`,
+		},
+	},
+}
+
+func newTestCLI(out *bytes.Buffer) *cli {
+	return &cli{
+		out: out,
+		open: func(version string) (destiny2.ManifestSource, error) {
+			if version == "" {
+				version = "v2"
+			}
+			m, ok := versions[version]
+			if !ok {
+				return nil, errors.New("unknown version")
+			}
+			return m, nil
+		},
+		format: "json",
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		setup    func(c *cli)
+		want     string
+		wantErr  bool
+		usageErr bool
+	}{
+		{name: "version", command: "version", want: "v2\n"},
+		{name: "contracts", command: "contracts", want: "DestinyLoreDefinition\nDestinyProgressionDefinition\n"},
+		{
+			name:    "get",
+			command: "get",
+			args:    []string{"Lore", "4"},
+			want:    "{\n  \"DisplayProperties\": {\n    \"Description\": \"\",\n    \"Name\": \"The Witch Queen\",\n    \"Icon\": \"\",\n    \"IconSequences\": null,\n    \"HighResIcon\": \"\",\n    \"HasIcon\": false\n  },\n  \"Subtitle\": \"\",\n  \"Hash\": 4,\n  \"Index\": 7,\n  \"Redacted\": false\n}\n",
+		},
+		{name: "get missing", command: "get", args: []string{"DestinyLoreDefinition", "3"}, wantErr: true},
+		{name: "get unknown contract", command: "get", args: []string{"Nope", "1"}, wantErr: true, usageErr: true},
+		{name: "get invalid hash", command: "get", args: []string{"Lore", "ace"}, wantErr: true, usageErr: true},
+		{name: "find-hash", command: "find-hash", args: []string{"2"}, want: "DestinyLoreDefinition\t2\tMarasenna, Revised\n"},
+		{name: "find-hash progression", command: "find-hash", args: []string{"5"}, want: "DestinyProgressionDefinition\t5\tValor Rank\n"},
+		{name: "find-hash missing", command: "find-hash", args: []string{"9"}, wantErr: true},
+		{name: "search", command: "search", args: []string{"the"}, want: "DestinyLoreDefinition\t1\tAce in the Hole\nDestinyLoreDefinition\t4\tThe Witch Queen\n"},
+		{name: "search progression", command: "search", args: []string{"valor"}, want: "DestinyProgressionDefinition\t5\tValor Rank\n"},
+		{
+			name:    "search with limit",
+			command: "search",
+			args:    []string{"the"},
+			setup:   func(c *cli) { c.limit, c.contract = 1, "Lore" },
+			want:    "DestinyLoreDefinition\t1\tAce in the Hole\n",
+		},
+		{
+			name:    "dump jsonl",
+			command: "dump",
+			args:    []string{"Lore"},
+			setup:   func(c *cli) { c.format = "jsonl" },
+			want: `{"DisplayProperties":{"Description":"A gambler's tale","Name":"Ace in the Hole","Icon":"","IconSequences":null,"HighResIcon":"","HasIcon":false},"Subtitle":"","Hash":1,"Index":5,"Redacted":false}
+{"DisplayProperties":{"Description":"","Name":"Marasenna, Revised","Icon":"","IconSequences":null,"HighResIcon":"","HasIcon":false},"Subtitle":"","Hash":2,"Index":6,"Redacted":false}
+{"DisplayProperties":{"Description":"","Name":"The Witch Queen","Icon":"","IconSequences":null,"HighResIcon":"","HasIcon":false},"Subtitle":"","Hash":4,"Index":7,"Redacted":false}
+`,
+		},
+		{
+			name:    "dump csv",
+			command: "dump",
+			args:    []string{"Lore"},
+			setup:   func(c *cli) { c.format = "csv" },
+			want: `hash,name,description,icon,redacted,entity
+1,Ace in the Hole,A gambler's tale,,false,"{""DisplayProperties"":{""Description"":""A gambler's tale"",""Name"":""Ace in the Hole"",""Icon"":"""",""IconSequences"":null,""HighResIcon"":"""",""HasIcon"":false},""Subtitle"":"""",""Hash"":1,""Index"":5,""Redacted"":false}"
+2,"Marasenna, Revised",,,false,"{""DisplayProperties"":{""Description"":"""",""Name"":""Marasenna, Revised"",""Icon"":"""",""IconSequences"":null,""HighResIcon"":"""",""HasIcon"":false},""Subtitle"":"""",""Hash"":2,""Index"":6,""Redacted"":false}"
+4,The Witch Queen,,,false,"{""DisplayProperties"":{""Description"":"""",""Name"":""The Witch Queen"",""Icon"":"""",""IconSequences"":null,""HighResIcon"":"""",""HasIcon"":false},""Subtitle"":"""",""Hash"":4,""Index"":7,""Redacted"":false}"
+`,
+		},
+		{name: "dump unknown format", command: "dump", args: []string{"Lore"}, setup: func(c *cli) { c.format = "xml" }, wantErr: true, usageErr: true},
+		{
+			name:    "diff",
+			command: "diff",
+			args:    []string{"v1", "v2"},
+			want:    "~\tDestinyLoreDefinition\t2\tMarasenna, Revised\n-\tDestinyLoreDefinition\t3\tUnveiling\n+\tDestinyLoreDefinition\t4\tThe Witch Queen\n+\tDestinyProgressionDefinition\t5\tValor Rank\n-\tDestinyStatDefinition\t1\tMobility\n",
+		},
+		{name: "diff unknown version", command: "diff", args: []string{"v1", "v9"}, wantErr: true},
+		{name: "wrong number of arguments", command: "get", args: []string{"Lore"}, wantErr: true, usageErr: true},
+		{name: "unknown command", command: "frobnicate", wantErr: true, usageErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			c := newTestCLI(&out)
+			if test.setup != nil {
+				test.setup(c)
+			}
+
+			err := c.run(test.command, test.args)
+			if gotErr := err != nil; gotErr != test.wantErr {
+				t.Fatalf("run(%q, %q) error = %v, want error: %t", test.command, test.args, err, test.wantErr)
+			}
+			if _, ok := err.(usageError); ok != test.usageErr {
+				t.Errorf("run(%q, %q) error = %v, want usage error: %t", test.command, test.args, err, test.usageErr)
+			}
+			if diff := cmp.Diff(test.want, out.String()); diff != "" {
+				t.Errorf("run(%q, %q) output mismatch (-want +got):\n%s", test.command, test.args, diff)
+			}
+		})
+	}
+}
+
+func TestParseInterspersed(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	locale := fs.String("locale", "en", "")
+	format := fs.String("format", "json", "")
+	offline := fs.Bool("offline", false, "")
+
+	args := parseInterspersed(fs, []string{"-offline", "dump", "Lore", "--locale", "de", "--format=csv"})
+	if diff := cmp.Diff([]string{"dump", "Lore"}, args); diff != "" {
+		t.Errorf("parseInterspersed() args mismatch (-want +got):\n%s", diff)
+	}
+	if *locale != "de" || *format != "csv" || !*offline {
+		t.Errorf("parseInterspersed() flags: locale = %q, format = %q, offline = %t; want de, csv, true", *locale, *format, *offline)
+	}
+}
@@ -0,0 +1,105 @@
+// Command d2 explores the Destiny 2 manifest from the command line.
+//
+// Usage:
+//
+//	d2 [flags] <command> [args] [flags]
+//
+// Commands:
+//
+//	version                    the manifest version
+//	contracts                  the contracts in the manifest
+//	get <contract> <hash>      an entity as JSON
+//	find-hash <hash>           every entity with a hash, in any contract
+//	search <text>              entities whose name contains text
+//	dump <contract>            every entity in a contract, as json, jsonl or csv
+//	diff <v1> <v2>             entities added, removed or changed between two stored versions
+//
+// Contracts may be named as in the Bungie.net API, such as DestinyInventoryItemDefinition, or without the
+// Destiny prefix and Definition suffix, such as InventoryItem.
+//
+// Every manifest version and contract read is kept in -dir, so once contracts have been read they are available
+// with -offline, which never contacts Bungie.net and uses the pinned or newest stored version.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/paranoiacblack/destiny2"
+)
+
+var flags = flag.NewFlagSet("d2", flag.ExitOnError)
+
+var (
+	dir      = flags.String("dir", defaultDir(), "directory where manifest versions and contracts are stored")
+	offline  = flags.Bool("offline", false, "never contact Bungie.net; use the pinned or newest stored version")
+	mobile   = flags.Bool("mobile", false, "read contracts from the mobile manifest")
+	locale   = flags.String("locale", "en", "Bungie.net locale of entities, such as de or pt-br")
+	format   = flags.String("format", "json", "output format of dump: json, jsonl or csv")
+	contract = flags.String("contract", "", "contract to search or diff; every contract if empty")
+	limit    = flags.Int("limit", 0, "maximum number of search results; unlimited if 0")
+)
+
+func defaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "destiny2"
+	}
+	return filepath.Join(dir, "destiny2")
+}
+
+func main() {
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: d2 [flags] version|contracts|get|find-hash|search|dump|diff [args]")
+		flags.PrintDefaults()
+	}
+	args := parseInterspersed(flags, os.Args[1:])
+	if len(args) == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	store := destiny2.VersionStore{Dir: *dir}
+	reader := destiny2.CachingReader(&destiny2.BungieAPIReader{}, destiny2.DirStore(filepath.Join(*dir, "contracts")))
+	if *offline {
+		reader = destiny2.ReadOnlyReader(reader)
+	}
+	defer reader.Close()
+
+	c := &cli{
+		out:      os.Stdout,
+		format:   *format,
+		contract: *contract,
+		limit:    *limit,
+		opts:     []destiny2.FulfillmentOption{destiny2.WithLocale(*locale), destiny2.UseMobileManifest(*mobile)},
+		open: func(version string) (destiny2.ManifestSource, error) {
+			if version == "" && !*offline {
+				return destiny2.NewManifestFromStore(reader, store)
+			}
+			return destiny2.NewManifestAtVersion(reader, store, version)
+		},
+	}
+	if err := c.run(args[0], args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "d2: %v\n", err)
+		if _, ok := err.(usageError); ok {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+// parseInterspersed parses flags anywhere in args, such as after a command's arguments, and returns the other arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) []string {
+	var rest []string
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			return rest
+		}
+		rest = append(rest, args[0])
+		args = args[1:]
+	}
+}
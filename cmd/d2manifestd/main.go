@@ -0,0 +1,90 @@
+// Command d2manifestd serves the Destiny 2 manifest as read-only JSON over HTTP.
+//
+// Endpoints:
+//
+//	GET /{locale}/{contract}/{hash}              a single entity
+//	GET /{locale}/{contract}?hash=1,2&hash=3     a batch of entities, with any missing hashes
+//	GET /{locale}/search?q=ace&contract=&limit=  entities whose name contains q
+//	GET /healthz                                 the manifest version and the result of the last update
+//	GET or POST /graphql                         a GraphQL query over entities and their relationships
+//
+// Contracts are named as in the Bungie.net API, such as DestinyInventoryItemDefinition, and locales are
+// Bungie.net locales such as en or pt-br. Responses have an ETag of the manifest version, except for GraphQL
+// queries, whose locale is given by the locale parameter or the Accept-Language header.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/paranoiacblack/destiny2"
+	"github.com/paranoiacblack/destiny2/graph"
+)
+
+var (
+	addr           = flag.String("addr", ":8080", "address to serve HTTP on")
+	readerName     = flag.String("reader", "api", "how contracts are read: api, cache or aggregate")
+	cacheDir       = flag.String("cache_dir", "", "directory of binary contract caches, used by the cache reader")
+	storeDir       = flag.String("store_dir", "", "if set, directory where manifest versions are stored so a pinned version is served across restarts")
+	mobile         = flag.Bool("mobile", false, "read contracts from the mobile manifest")
+	updateInterval = flag.Duration("update_interval", time.Hour, "how often to check for a new manifest version; 0 disables updates")
+)
+
+func main() {
+	flag.Parse()
+
+	reader, opts, err := newReader(*readerName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer reader.Close()
+	if *mobile {
+		opts = append(opts, destiny2.UseMobileManifest(true))
+	}
+
+	var m *destiny2.Manifest
+	if *storeDir != "" {
+		m, err = destiny2.NewManifestFromStore(reader, destiny2.VersionStore{Dir: *storeDir})
+	} else {
+		m, err = destiny2.NewManifest(reader)
+	}
+	if err != nil {
+		log.Fatalf("loading manifest: %v", err)
+	}
+	log.Printf("loaded manifest version %s", m.Version())
+
+	srv := newServer(m, opts...)
+	if *updateInterval > 0 {
+		go srv.autoUpdate(*updateInterval, nil)
+	}
+
+	gh, err := graph.NewHandler(srv.contracts)
+	if err != nil {
+		log.Fatalf("building GraphQL schema: %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", gh)
+	mux.Handle("/", srv)
+
+	log.Printf("serving on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
+
+// newReader returns the ContractReader with a given name and any options needed to fulfill contracts with it.
+func newReader(name string) (destiny2.ContractReader, []destiny2.FulfillmentOption, error) {
+	switch name {
+	case "api":
+		return &destiny2.BungieAPIReader{}, nil, nil
+	case "cache":
+		if *cacheDir == "" {
+			return nil, nil, fmt.Errorf("the cache reader requires -cache_dir")
+		}
+		return &destiny2.BinaryCacheReader{Dir: *cacheDir, Source: &destiny2.BungieAPIReader{}}, nil, nil
+	case "aggregate":
+		return &destiny2.AggregateReader{}, []destiny2.FulfillmentOption{destiny2.UseAggregateManifest(true)}, nil
+	}
+	return nil, nil, fmt.Errorf("%q is not a known reader; use api, cache or aggregate", name)
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/paranoiacblack/destiny2"
+)
+
+// updateManifest returns an updated copy of a *destiny2.Manifest, leaving m unchanged so it can be served during the update.
+func updateManifest(m destiny2.ManifestSource) (destiny2.ManifestSource, error) {
+	next := *m.(*destiny2.Manifest)
+	if err := next.Update(nil); err != nil {
+		return nil, err
+	}
+	return &next, nil
+}
+
+// server serves entities from a manifest as JSON.
+type server struct {
+	// contracts caches the contracts fulfilled from the manifest, dropping them when its version changes.
+	contracts *destiny2.ContractCache
+
+	// refresh returns an updated copy of a manifest. It is updateManifest except in tests.
+	refresh func(destiny2.ManifestSource) (destiny2.ManifestSource, error)
+	// updating serializes updates, which run without holding mu so the manifest can be served meanwhile.
+	updating sync.Mutex
+
+	// mu guards the manifest, which is replaced rather than changed by updates, and the result of the last update.
+	mu         sync.RWMutex
+	manifest   destiny2.ManifestSource
+	lastUpdate time.Time
+	updateErr  error
+}
+
+func newServer(m destiny2.ManifestSource, opts ...destiny2.FulfillmentOption) *server {
+	s := &server{refresh: updateManifest, manifest: m, lastUpdate: time.Now()}
+	s.contracts = destiny2.NewContractCache(s, opts...)
+	return s
+}
+
+// httpError is an error with the HTTP status code to respond with.
+type httpError struct {
+	code int
+	msg  string
+}
+
+func (e httpError) Error() string {
+	return e.msg
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, httpError{http.StatusMethodNotAllowed, "only GET requests are supported"})
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) == 1 && parts[0] == "healthz" {
+		s.health(w)
+		return
+	}
+
+	s.mu.RLock()
+	version := s.manifest.Version()
+	s.mu.RUnlock()
+
+	etag := strconv.Quote(version)
+	w.Header().Set("ETag", etag)
+	if match := r.Header.Get("If-None-Match"); match != "" && (match == etag || match == "*") {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var body interface{}
+	var err error
+	switch {
+	case len(parts) == 2 && parts[1] == "search":
+		body, err = s.search(parts[0], r)
+	case len(parts) == 2:
+		body, err = s.batch(parts[0], parts[1], r)
+	case len(parts) == 3:
+		body, err = s.entity(parts[0], parts[1], parts[2])
+	default:
+		err = httpError{http.StatusNotFound, fmt.Sprintf("%s is not a valid path", r.URL.Path)}
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// entity serves /{locale}/{contract}/{hash}.
+func (s *server) entity(locale, name, hash string) (interface{}, error) {
+	h, err := parseHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := s.contract(name, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, ok := destiny2.FindEntity(contract, h)
+	if !ok {
+		return nil, httpError{http.StatusNotFound, fmt.Sprintf("%s has no entity with hash %d", name, h)}
+	}
+	return entity, nil
+}
+
+// batchResponse is the response to a batched lookup.
+type batchResponse struct {
+	Entities map[uint32]interface{} `json:"entities"`
+	Missing  []uint32               `json:"missing"`
+}
+
+// batch serves /{locale}/{contract}?hash=1&hash=2, where hashes may also be comma-separated.
+func (s *server) batch(locale, name string, r *http.Request) (interface{}, error) {
+	var hashes []uint32
+	for _, values := range r.URL.Query()["hash"] {
+		for _, value := range strings.Split(values, ",") {
+			h, err := parseHash(value)
+			if err != nil {
+				return nil, err
+			}
+			hashes = append(hashes, h)
+		}
+	}
+	if len(hashes) == 0 {
+		return nil, httpError{http.StatusBadRequest, "at least one hash parameter is required"}
+	}
+
+	contract, err := s.contract(name, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := batchResponse{Entities: map[uint32]interface{}{}, Missing: []uint32{}}
+	for _, h := range hashes {
+		if entity, ok := destiny2.FindEntity(contract, h); ok {
+			resp.Entities[h] = entity
+		} else {
+			resp.Missing = append(resp.Missing, h)
+		}
+	}
+	return resp, nil
+}
+
+// searchResult is a single entity matching a search.
+type searchResult struct {
+	Contract string      `json:"contract"`
+	Hash     uint32      `json:"hash"`
+	Entity   interface{} `json:"entity"`
+}
+
+// search serves /{locale}/search?q=query&contract=name&limit=n.
+// Every contract in the manifest is searched if no contract is given.
+func (s *server) search(locale string, r *http.Request) (interface{}, error) {
+	query := r.URL.Query()
+	q := query.Get("q")
+	if q == "" {
+		return nil, httpError{http.StatusBadRequest, "the q parameter is required"}
+	}
+
+	limit := 0
+	if l := query.Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 0 {
+			return nil, httpError{http.StatusBadRequest, fmt.Sprintf("%q is not a valid limit", l)}
+		}
+	}
+
+	var contracts []destiny2.Contract
+	if name := query.Get("contract"); name != "" {
+		contract, err := s.contract(name, locale)
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, contract)
+	} else {
+		for _, name := range s.Contracts() {
+			contract, err := s.contract(name, locale)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, contract)
+		}
+	}
+
+	results := []searchResult{}
+	for _, contract := range contracts {
+		for _, h := range destiny2.SearchContract(contract, q) {
+			if limit > 0 && len(results) >= limit {
+				return results, nil
+			}
+			entity, _ := destiny2.FindEntity(contract, h)
+			results = append(results, searchResult{Contract: contract.Name(), Hash: h, Entity: entity})
+		}
+	}
+	return results, nil
+}
+
+// Version returns the version of the manifest being served.
+func (s *server) Version() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.manifest.Version()
+}
+
+// Contracts returns the names of the contracts in the manifest being served.
+func (s *server) Contracts() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.manifest.Contracts()
+}
+
+// FulfillContract fulfills definition from the manifest being served, so the server can back its ContractCache.
+// The lock isn't held while fulfilling, since updates replace the manifest rather than change it.
+func (s *server) FulfillContract(definition destiny2.Contract, opts ...destiny2.FulfillmentOption) error {
+	s.mu.RLock()
+	m := s.manifest
+	s.mu.RUnlock()
+	return m.FulfillContract(definition, opts...)
+}
+
+// contract returns the fulfilled contract with a given name and locale, fulfilling it if it isn't cached.
+func (s *server) contract(name, locale string) (destiny2.Contract, error) {
+	contract, err := s.contracts.Contract(name, locale)
+	if errors.As(err, &destiny2.ContractError{}) || errors.As(err, &destiny2.LocaleError{}) {
+		return nil, httpError{http.StatusNotFound, err.Error()}
+	}
+	if err != nil {
+		return nil, httpError{http.StatusBadGateway, fmt.Sprintf("fulfilling %s: %v", name, err)}
+	}
+	return contract, nil
+}
+
+// healthResponse describes the state of the server.
+type healthResponse struct {
+	Status      string    `json:"status"`
+	Version     string    `json:"version"`
+	LastUpdate  time.Time `json:"lastUpdate"`
+	UpdateError string    `json:"updateError,omitempty"`
+}
+
+// health serves /healthz. A failed update doesn't make the server unhealthy, since the previous manifest is still served.
+func (s *server) health(w http.ResponseWriter) {
+	s.mu.RLock()
+	resp := healthResponse{Status: "ok", Version: s.manifest.Version(), LastUpdate: s.lastUpdate}
+	if s.updateErr != nil {
+		resp.Status = "degraded"
+		resp.UpdateError = s.updateErr.Error()
+	}
+	s.mu.RUnlock()
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// update updates the manifest. Cached contracts are dropped by the ContractCache if there is a new version.
+// The previous manifest is served until the update finishes.
+func (s *server) update() {
+	s.updating.Lock()
+	defer s.updating.Unlock()
+
+	s.mu.RLock()
+	current := s.manifest
+	s.mu.RUnlock()
+	next, err := s.refresh(current)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updateErr = err
+	if err != nil {
+		log.Printf("updating manifest: %v", err)
+		return
+	}
+	s.manifest = next
+	s.lastUpdate = time.Now()
+}
+
+// autoUpdate updates the manifest every interval until done is closed.
+func (s *server) autoUpdate(interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.update()
+		case <-done:
+			return
+		}
+	}
+}
+
+func parseHash(s string) (uint32, error) {
+	h, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, httpError{http.StatusBadRequest, fmt.Sprintf("%q is not a valid hash", s)}
+	}
+	return uint32(h), nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("writing response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	var herr httpError
+	if errors.As(err, &herr) {
+		code = herr.code
+	}
+	w.Header().Del("ETag")
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
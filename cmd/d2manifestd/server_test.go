@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/paranoiacblack/destiny2"
+)
+
+// fakeManifest serves contracts from JSON, and is updated to version next by refreshFake.
+type fakeManifest struct {
+	version   string
+	contracts map[string]string
+	next      string
+	updateErr error
+}
+
+func (m *fakeManifest) Version() string {
+	return m.version
+}
+
+func (m *fakeManifest) Contracts() []string {
+	var names []string
+	for name := range m.contracts {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (m *fakeManifest) FulfillContract(definition destiny2.Contract, opts ...destiny2.FulfillmentOption) error {
+	return definition.Unmarshal([]byte(m.contracts[definition.Name()]))
+}
+
+// refreshFake is a server's refresh function for a *fakeManifest, returning a copy at version next.
+func refreshFake(m destiny2.ManifestSource) (destiny2.ManifestSource, error) {
+	current := m.(*fakeManifest)
+	if current.updateErr != nil {
+		return nil, current.updateErr
+	}
+	next := *current
+	next.version = current.next
+	return &next, nil
+}
+
+func get(t *testing.T, srv http.Handler, path string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+	return w
+}
+
+func TestServer(t *testing.T) {
+	m := &fakeManifest{
+		version: "v1",
+		next:    "v1",
+		contracts: map[string]string{
+			"DestinyLoreDefinition": `{
+				"1": {"hash": 1, "displayProperties": {"name": "Ace in the Hole"}},
+				"2": {"hash": 2, "displayProperties": {"name": "Marasenna"}}
+			}`,
+		},
+	}
+	srv := newServer(m)
+
+	tests := []struct {
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{"/en/DestinyLoreDefinition/1", http.StatusOK, `{"DisplayProperties":{"Description":"","Name":"Ace in the Hole","Icon":"","IconSequences":null,"HighResIcon":"","HasIcon":false},"Subtitle":"","Hash":1,"Index":0,"Redacted":false}`},
+		{"/en/DestinyLoreDefinition/3", http.StatusNotFound, `{"error":"DestinyLoreDefinition has no entity with hash 3"}`},
+		{"/en/DestinyLoreDefinition/ace", http.StatusBadRequest, `{"error":"\"ace\" is not a valid hash"}`},
+		{"/xx/DestinyLoreDefinition/1", http.StatusNotFound, `{"error":"\"xx\" is unsupported by the Bungie API"}`},
+		{"/en/DestinyNotADefinition/1", http.StatusNotFound, `{"error":"\"DestinyNotADefinition\" is not a known Destiny.Definitions name"}`},
+		{"/en/DestinyLoreDefinition?hash=2,3&hash=1", http.StatusOK, `{"entities":{"1":{"DisplayProperties":{"Description":"","Name":"Ace in the Hole","Icon":"","IconSequences":null,"HighResIcon":"","HasIcon":false},"Subtitle":"","Hash":1,"Index":0,"Redacted":false},"2":{"DisplayProperties":{"Description":"","Name":"Marasenna","Icon":"","IconSequences":null,"HighResIcon":"","HasIcon":false},"Subtitle":"","Hash":2,"Index":0,"Redacted":false}},"missing":[3]}`},
+		{"/en/search?q=MARA", http.StatusOK, `[{"contract":"DestinyLoreDefinition","hash":2,"entity":{"DisplayProperties":{"Description":"","Name":"Marasenna","Icon":"","IconSequences":null,"HighResIcon":"","HasIcon":false},"Subtitle":"","Hash":2,"Index":0,"Redacted":false}}]`},
+		{"/en/search", http.StatusBadRequest, `{"error":"the q parameter is required"}`},
+	}
+	for _, test := range tests {
+		w := get(t, srv, test.path, nil)
+		if w.Code != test.wantCode {
+			t.Errorf("GET %s: got status %d, want %d", test.path, w.Code, test.wantCode)
+		}
+		if diff := cmp.Diff(test.wantBody+"\n", w.Body.String()); diff != "" {
+			t.Errorf("GET %s: (-want +got)\n%s", test.path, diff)
+		}
+	}
+}
+
+func TestServerETag(t *testing.T) {
+	m := &fakeManifest{
+		version:   "v1",
+		next:      "v1",
+		contracts: map[string]string{"DestinyLoreDefinition": `{"1": {"hash": 1, "subtitle": "old"}}`},
+	}
+	srv := newServer(m)
+	srv.refresh = refreshFake
+
+	w := get(t, srv, "/en/DestinyLoreDefinition/1", nil)
+	etag := w.Header().Get("ETag")
+	if etag != `"v1"` {
+		t.Fatalf("ETag: got %s, want %q", etag, `"v1"`)
+	}
+	if w := get(t, srv, "/en/DestinyLoreDefinition/1", http.Header{"If-None-Match": {etag}}); w.Code != http.StatusNotModified {
+		t.Errorf("GET with current ETag: got status %d, want %d", w.Code, http.StatusNotModified)
+	}
+
+	// A failed update keeps serving the previous version and is reported by the health endpoint.
+	m.updateErr = errors.New("maintenance")
+	srv.update()
+	var health healthResponse
+	if err := json.Unmarshal(get(t, srv, "/healthz", nil).Body.Bytes(), &health); err != nil {
+		t.Fatal(err)
+	}
+	if health.Status != "degraded" || health.Version != "v1" || health.UpdateError != "maintenance" {
+		t.Errorf("health after failed update: got %+v", health)
+	}
+
+	m.updateErr = nil
+	m.next = "v2"
+	m.contracts["DestinyLoreDefinition"] = `{"1": {"hash": 1, "subtitle": "new"}}`
+	srv.update()
+
+	w = get(t, srv, "/en/DestinyLoreDefinition/1", http.Header{"If-None-Match": {etag}})
+	if w.Code != http.StatusOK {
+		t.Errorf("GET with stale ETag: got status %d, want %d", w.Code, http.StatusOK)
+	}
+	var lore destiny2.LoreEntity
+	if err := json.Unmarshal(w.Body.Bytes(), &lore); err != nil {
+		t.Fatal(err)
+	}
+	if lore.Subtitle != "new" {
+		t.Errorf("GET after update: got subtitle %q, want %q", lore.Subtitle, "new")
+	}
+}
+
+func TestServerServesDuringUpdate(t *testing.T) {
+	m := &fakeManifest{
+		version:   "v1",
+		next:      "v2",
+		contracts: map[string]string{"DestinyLoreDefinition": `{"1": {"hash": 1, "subtitle": "old"}}`},
+	}
+	srv := newServer(m)
+	updating, release := make(chan struct{}), make(chan struct{})
+	srv.refresh = func(m destiny2.ManifestSource) (destiny2.ManifestSource, error) {
+		close(updating)
+		<-release
+		return refreshFake(m)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		srv.update()
+		close(done)
+	}()
+	<-updating
+	if w := get(t, srv, "/en/DestinyLoreDefinition/1", nil); w.Code != http.StatusOK || w.Header().Get("ETag") != `"v1"` {
+		t.Errorf("GET during update: got status %d and ETag %s, want %d and %q", w.Code, w.Header().Get("ETag"), http.StatusOK, `"v1"`)
+	}
+	close(release)
+	<-done
+	if version := srv.Version(); version != "v2" {
+		t.Errorf("Version after update: got %q, want %q", version, "v2")
+	}
+}
@@ -2,6 +2,7 @@ package destiny2
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 // Contract is a collection of Destiny.Definitions which have their own tables in the Manifest Database.
@@ -1437,3 +1438,94 @@ func (def *ReportReasonCategoryDefinition) Unmarshal(data []byte) error {
 func (def ReportReasonCategoryDefinition) Entity(entityHash uint32) interface{} {
 	return def[entityHash]
 }
+
+// contractConstructors creates an empty instance of every contract.
+var contractConstructors = []func() Contract{
+	func() Contract { return &InventoryItemDefinition{} },
+	func() Contract { return &ProgressionDefinition{} },
+	func() Contract { return &InventoryBucketDefinition{} },
+	func() Contract { return &ItemTierTypeDefinition{} },
+	func() Contract { return &StatDefinition{} },
+	func() Contract { return &StatGroupDefinition{} },
+	func() Contract { return &EquipmentSlotDefinition{} },
+	func() Contract { return &SocketTypeDefinition{} },
+	func() Contract { return &SocketCategoryDefinition{} },
+	func() Contract { return &DestinationDefinition{} },
+	func() Contract { return &ActivityGraphDefinition{} },
+	func() Contract { return &ActivityDefinition{} },
+	func() Contract { return &ActivityModifierDefinition{} },
+	func() Contract { return &ObjectiveDefinition{} },
+	func() Contract { return &SandboxPerkDefinition{} },
+	func() Contract { return &LocationDefinition{} },
+	func() Contract { return &ActivityModeDefinition{} },
+	func() Contract { return &PlaceDefinition{} },
+	func() Contract { return &ActivityTypeDefinition{} },
+	func() Contract { return &VendorGroupDefinition{} },
+	func() Contract { return &FactionDefinition{} },
+	func() Contract { return &ArtifactDefinition{} },
+	func() Contract { return &PowerCapDefinition{} },
+	func() Contract { return &ProgressionLevelRequirementDefinition{} },
+	func() Contract { return &RewardSourceDefinition{} },
+	func() Contract { return &TraitDefinition{} },
+	func() Contract { return &TraitCategoryDefinition{} },
+	func() Contract { return &PresentationNodeDefinition{} },
+	func() Contract { return &CollectibleDefinition{} },
+	func() Contract { return &MaterialRequirementSetDefinition{} },
+	func() Contract { return &RecordDefinition{} },
+	func() Contract { return &GenderDefinition{} },
+	func() Contract { return &VendorDefinition{} },
+	func() Contract { return &LoreDefinition{} },
+	func() Contract { return &MetricDefinition{} },
+	func() Contract { return &EnergyTypeDefinition{} },
+	func() Contract { return &PlugSetDefinition{} },
+	func() Contract { return &TalentGridDefinition{} },
+	func() Contract { return &DamageTypeDefinition{} },
+	func() Contract { return &ItemCategoryDefinition{} },
+	func() Contract { return &BreakerTypeDefinition{} },
+	func() Contract { return &SeasonDefinition{} },
+	func() Contract { return &SeasonPassDefinition{} },
+	func() Contract { return &ChecklistDefinition{} },
+	func() Contract { return &RaceDefinition{} },
+	func() Contract { return &ClassDefinition{} },
+	func() Contract { return &MilestoneDefinition{} },
+	func() Contract { return &UnlockDefinition{} },
+	func() Contract { return &ReportReasonCategoryDefinition{} },
+}
+
+// ContractError represents a contract name that isn't a known Destiny.Definitions name.
+type ContractError struct {
+	name string
+}
+
+func (e ContractError) Error() string {
+	return fmt.Sprintf("%q is not a known Destiny.Definitions name", e.name)
+}
+
+// EntityError represents a hash that refers to an entity missing from its contract.
+type EntityError struct {
+	contract string
+	hash     uint32
+}
+
+func (e EntityError) Error() string {
+	return fmt.Sprintf("%s has no entity with hash %d", e.contract, e.hash)
+}
+
+// AllContracts returns an empty instance of every contract, ready to be fulfilled.
+func AllContracts() []Contract {
+	contracts := make([]Contract, len(contractConstructors))
+	for i, newContract := range contractConstructors {
+		contracts[i] = newContract()
+	}
+	return contracts
+}
+
+// NewContract returns an empty instance of the contract with a given name, such as "DestinyInventoryItemDefinition".
+func NewContract(name string) (Contract, error) {
+	for _, newContract := range contractConstructors {
+		if contract := newContract(); contract.Name() == name {
+			return contract, nil
+		}
+	}
+	return nil, ContractError{name}
+}
@@ -0,0 +1,242 @@
+package destiny2
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"time"
+)
+
+// bungieURL is the root of all Bungie.net content paths.
+var bungieURL = "https://www.bungie.net"
+
+// downloadAttempts is the number of times a download is attempted before giving up.
+const downloadAttempts = 3
+
+// downloadBackoff is the delay before the first retry, doubled after each attempt.
+var downloadBackoff = time.Second
+
+// VerificationFailure classifies why downloaded content was rejected.
+type VerificationFailure int
+
+const (
+	// FailedRequest means the request itself failed, such as a network error.
+	FailedRequest VerificationFailure = iota
+	// FailedStatus means Bungie.net responded with an unsuccessful HTTP status code.
+	FailedStatus
+	// FailedContentType means the response was not the expected type of content, such as an HTML error page.
+	FailedContentType
+	// FailedJSON means a contract was not well-formed JSON.
+	FailedJSON
+	// FailedZip means a mobile manifest was not a valid zip archive.
+	FailedZip
+	// FailedSQLite means a mobile manifest archive did not contain a SQLite database.
+	FailedSQLite
+	// FailedMissingTable means a mobile manifest did not contain the table for a contract.
+	FailedMissingTable
+)
+
+func (f VerificationFailure) String() string {
+	switch f {
+	case FailedRequest:
+		return "request failed"
+	case FailedStatus:
+		return "unsuccessful status"
+	case FailedContentType:
+		return "unexpected content type"
+	case FailedJSON:
+		return "malformed JSON"
+	case FailedZip:
+		return "invalid zip archive"
+	case FailedSQLite:
+		return "invalid SQLite database"
+	case FailedMissingTable:
+		return "missing table"
+	}
+	return "unknown failure"
+}
+
+// VerificationError represents downloaded content that failed verification.
+type VerificationError struct {
+	// Path is the Bungie.net path of the content.
+	Path string
+	// Failure classifies what went wrong.
+	Failure VerificationFailure
+	// Detail describes what went wrong.
+	Detail string
+	// StatusCode is the HTTP status code of the response, if there was one.
+	StatusCode int
+}
+
+func (e VerificationError) Error() string {
+	return fmt.Sprintf("verifying %q: %s: %s", e.Path, e.Failure, e.Detail)
+}
+
+// temporary reports whether downloading again might succeed.
+func (e VerificationError) temporary() bool {
+	switch e.Failure {
+	case FailedStatus:
+		return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+	case FailedMissingTable:
+		return false
+	}
+	return true
+}
+
+// fetch requests path from Bungie.net and verifies the status code and content type of the response.
+// An unsuccessful response with a Bungie.net error in its JSON body, such as during maintenance or when
+// throttled, returns that APIError. HTML and XML responses are rejected since they are error pages rather than content.
+// The caller must close the response body.
+func fetch(path string) (*http.Response, error) {
+	resp, err := http.Get(bungieURL + path)
+	if err != nil {
+		return nil, VerificationError{Path: path, Failure: FailedRequest, Detail: err.Error()}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		if apiErr, ok := responseAPIError(resp); ok {
+			return nil, apiErr
+		}
+		return nil, VerificationError{Path: path, Failure: FailedStatus, Detail: resp.Status, StatusCode: resp.StatusCode}
+	}
+
+	if header := resp.Header.Get("Content-Type"); header != "" {
+		mediaType, _, err := mime.ParseMediaType(header)
+		if err != nil || mediaType == "text/html" || mediaType == "text/xml" || mediaType == "application/xml" {
+			resp.Body.Close()
+			return nil, VerificationError{Path: path, Failure: FailedContentType, Detail: header, StatusCode: resp.StatusCode}
+		}
+	}
+	return resp, nil
+}
+
+// maxErrorBody is the most of an unsuccessful response's body read for a Bungie.net error.
+const maxErrorBody = 1 << 16
+
+// responseAPIError returns the Bungie.net error in the JSON body of an unsuccessful response, if it has one.
+func responseAPIError(resp *http.Response) (APIError, bool) {
+	var apiErr APIError
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxErrorBody)).Decode(&apiErr); err != nil {
+		return APIError{}, false
+	}
+	if apiErr.ErrorCode == 0 || apiErr.ErrorCode == ErrorCode_Success {
+		return APIError{}, false
+	}
+	return apiErr, true
+}
+
+// download requests path from Bungie.net until the response passes verify. Throttled requests are retried after
+// the delay requested by Bungie.net and temporary failures are retried with backoff.
+func download(path string, verify func([]byte) error) ([]byte, error) {
+	backoff := downloadBackoff
+	var err error
+	for attempt := 0; attempt < downloadAttempts; attempt++ {
+		var body []byte
+		body, err = downloadOnce(path, verify)
+		if err == nil {
+			return body, nil
+		}
+
+		delay := backoff
+		switch err := err.(type) {
+		case APIError:
+			if !err.throttled() {
+				return nil, err
+			}
+			delay = err.ThrottleSeconds
+		case VerificationError:
+			if !err.temporary() {
+				return nil, err
+			}
+			backoff *= 2
+		default:
+			backoff *= 2
+		}
+		if attempt+1 < downloadAttempts {
+			sleep(delay)
+		}
+	}
+	return nil, err
+}
+
+func downloadOnce(path string, verify func([]byte) error) ([]byte, error) {
+	resp, err := fetch(path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, VerificationError{Path: path, Failure: FailedRequest, Detail: err.Error(), StatusCode: resp.StatusCode}
+	}
+	if err := verify(body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// verifyJSON verifies a contract is well-formed JSON.
+func verifyJSON(path string) func([]byte) error {
+	return func(body []byte) error {
+		if !json.Valid(body) {
+			return VerificationError{Path: path, Failure: FailedJSON, Detail: "response body is not valid JSON"}
+		}
+		return nil
+	}
+}
+
+// sqliteHeader is the first 16 bytes of every SQLite database file.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// unzipDB returns the SQLite database within a zipped mobile manifest.
+// Reading each file in full also verifies its checksum, so truncated archives are rejected.
+func unzipDB(path string, body []byte) ([]byte, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return nil, VerificationError{Path: path, Failure: FailedZip, Detail: err.Error()}
+	}
+
+	for _, f := range zipReader.File {
+		fc, err := f.Open()
+		if err != nil {
+			return nil, VerificationError{Path: path, Failure: FailedZip, Detail: err.Error()}
+		}
+		content, err := ioutil.ReadAll(fc)
+		fc.Close()
+		if err != nil {
+			return nil, VerificationError{Path: path, Failure: FailedZip, Detail: fmt.Sprintf("%s: %v", f.Name, err)}
+		}
+
+		if bytes.HasPrefix(content, sqliteHeader) {
+			return content, nil
+		}
+	}
+	return nil, VerificationError{Path: path, Failure: FailedSQLite, Detail: "no SQLite database in archive"}
+}
+
+// verifyTable verifies the SQLite database at dbPath contains a table named contractName.
+func verifyTable(path, dbPath, contractName string) error {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return VerificationError{Path: path, Failure: FailedSQLite, Detail: err.Error()}
+	}
+	defer db.Close()
+
+	var name string
+	err = db.QueryRow("SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?", contractName).Scan(&name)
+	if err == sql.ErrNoRows {
+		return VerificationError{Path: path, Failure: FailedMissingTable, Detail: contractName}
+	}
+	if err != nil {
+		return VerificationError{Path: path, Failure: FailedSQLite, Detail: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package destiny2
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+// serveBungie points downloads at a test server for the duration of a test.
+func serveBungie(t *testing.T, handler http.Handler) {
+	srv := httptest.NewServer(handler)
+	oldURL, oldBackoff := bungieURL, downloadBackoff
+	bungieURL, downloadBackoff = srv.URL, 0
+	t.Cleanup(func() {
+		srv.Close()
+		bungieURL, downloadBackoff = oldURL, oldBackoff
+	})
+}
+
+// zippedDB returns a zipped SQLite database containing an empty table for each contract name.
+func zippedDB(t *testing.T, tables ...string) []byte {
+	path := filepath.Join(t.TempDir(), "manifest.sqlite")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("CREATE TABLE placeholder (id INTEGER)"); err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range tables {
+		if _, err := db.Exec("CREATE TABLE " + table + " (id INTEGER PRIMARY KEY, json BLOB)"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("world_sql_content.content")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(content)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestBungieAPIReaderVerification(t *testing.T) {
+	lore := LoreDefinition{}.Name()
+	validDB := zippedDB(t, lore)
+	attempts := map[string]int{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"1": {"hash": 1}}`))
+	})
+	mux.HandleFunc("/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html>Down for maintenance</html>"))
+	})
+	mux.HandleFunc("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		attempts["/flaky"]++
+		if attempts["/flaky"] == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/throttled", func(w http.ResponseWriter, r *http.Request) {
+		attempts["/throttled"]++
+		if attempts["/throttled"] == 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"ErrorCode": 31, "ThrottleSeconds": 0, "ErrorStatus": "ThrottleLimitExceeded"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		attempts["/missing"]++
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/truncated", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"1": {"hash": 1`))
+	})
+	mux.HandleFunc("/db", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(validDB)
+	})
+	mux.HandleFunc("/truncated-db", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(validDB[:len(validDB)/2])
+	})
+	mux.HandleFunc("/other-db", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(zippedDB(t, "DestinyGenderDefinition"))
+	})
+	serveBungie(t, mux)
+
+	reader := new(BungieAPIReader)
+	defer reader.Close()
+
+	tests := []struct {
+		path    string
+		mobile  bool
+		failure VerificationFailure
+		ok      bool
+	}{
+		{path: "/json", ok: true},
+		{path: "/flaky", ok: true},
+		{path: "/throttled", ok: true},
+		{path: "/maintenance", failure: FailedContentType},
+		{path: "/missing", failure: FailedStatus},
+		{path: "/truncated", failure: FailedJSON},
+		{path: "/db", mobile: true, ok: true},
+		{path: "/truncated-db", mobile: true, failure: FailedZip},
+		{path: "/json", mobile: true, failure: FailedZip},
+		{path: "/other-db", mobile: true, failure: FailedMissingTable},
+	}
+
+	for _, test := range tests {
+		_, err := reader.ReadContract(&LoreDefinition{}, test.path, test.mobile)
+		if test.ok {
+			if err != nil {
+				t.Errorf("ReadContract(%q, mobile=%t): %v", test.path, test.mobile, err)
+			}
+			continue
+		}
+
+		var verr VerificationError
+		if !errors.As(err, &verr) {
+			t.Errorf("ReadContract(%q, mobile=%t): got %v, want VerificationError", test.path, test.mobile, err)
+			continue
+		}
+		if verr.Failure != test.failure {
+			t.Errorf("ReadContract(%q, mobile=%t): got failure %q, want %q", test.path, test.mobile, verr.Failure, test.failure)
+		}
+	}
+
+	if attempts["/flaky"] != 2 {
+		t.Errorf("a temporary failure should be retried: got %d attempts, want 2", attempts["/flaky"])
+	}
+	if attempts["/missing"] != 1 {
+		t.Errorf("a missing contract should not be retried: got %d attempts, want 1", attempts["/missing"])
+	}
+}
@@ -0,0 +1,143 @@
+package destiny2
+
+import "fmt"
+
+// defaultMaxEnergy is the highest energy capacity armor can be upgraded to.
+const defaultMaxEnergy = 10
+
+// EnergyPlanError represents a set of mods that can't fit on an armor piece at any energy level.
+type EnergyPlanError struct {
+	msg string
+}
+
+func (e EnergyPlanError) Error() string {
+	return e.msg
+}
+
+// EnergyPlanner plans the energy armor needs for a set of mods and the cost of upgrading to it.
+type EnergyPlanner struct {
+	// Items are the mods and energy capacity plugs.
+	Items InventoryItemDefinition
+	// EnergyTypes are the energy types plugs refer to by EnergyTypeHash.
+	EnergyTypes EnergyTypeDefinition
+	// MaterialRequirements price the energy capacity plugs by their InsertionMaterialRequirementHash.
+	MaterialRequirements MaterialRequirementSetDefinition
+	// MaxEnergy is the highest energy capacity armor can be upgraded to. If MaxEnergy is 0, it is 10.
+	MaxEnergy int32
+}
+
+// EnergyPlan is the energy needed for a set of mods on an armor piece.
+type EnergyPlan struct {
+	// Current is the armor's energy, with Used being the total cost of the mods.
+	Current ItemEnergy
+	// Required is the minimal energy the armor needs for the mods, which is Current if they already fit.
+	Required ItemEnergy
+	// Upgrades are the hashes of the energy capacity plugs to insert to reach Required, lowest capacity first.
+	Upgrades []uint32
+	// Materials are the total materials needed for the upgrades, ordered by item hash.
+	Materials []MaterialCost
+}
+
+// Fits reports whether the mods fit without upgrading the armor.
+func (p EnergyPlan) Fits() bool {
+	return len(p.Upgrades) == 0 && p.Required == p.Current
+}
+
+// ModCost returns the energy cost of a mod: its investment in the cost stat of its energy type, or its EnergyCost
+// if it has no such investment. PlugChecker uses the same cost.
+func (p EnergyPlanner) ModCost(mod InventoryItemEntity) int32 {
+	_, cost := p.EnergyTypes.plugCost(mod)
+	return cost
+}
+
+// Plan returns the energy armor with energy needs for mods. If the mods don't fit, the plan suggests the lowest
+// energy capacity that fits them, keeping the armor's energy type unless a mod requires another.
+// An EnergyPlanError is returned if mods require different energy types or cost more than MaxEnergy.
+func (p EnergyPlanner) Plan(armor InventoryItemEntity, energy ItemEnergy, mods []InventoryItemEntity) (EnergyPlan, error) {
+	maxEnergy := p.MaxEnergy
+	if maxEnergy == 0 {
+		maxEnergy = defaultMaxEnergy
+	}
+
+	required := ItemEnergy{Type: energy.Type, Capacity: energy.Capacity}
+	modType := EnergyType_Any
+	for _, mod := range mods {
+		t, cost := p.EnergyTypes.plugCost(mod)
+		if t != EnergyType_Any {
+			if modType != EnergyType_Any && t != modType {
+				return EnergyPlan{}, EnergyPlanError{fmt.Sprintf("mods require both %s and %s energy", modType, t)}
+			}
+			modType = t
+		}
+		required.Used += cost
+	}
+	if required.Used > maxEnergy {
+		return EnergyPlan{}, EnergyPlanError{fmt.Sprintf("mods cost %d energy but armor has at most %d", required.Used, maxEnergy)}
+	}
+
+	plan := EnergyPlan{Current: energy}
+	plan.Current.Used = required.Used
+	if modType != EnergyType_Any {
+		required.Type = modType
+	}
+	if required.Used > required.Capacity {
+		required.Capacity = required.Used
+	}
+	plan.Required = required
+	if required == plan.Current {
+		return plan, nil
+	}
+
+	levels := p.energyLevels(armor, required.Type)
+	var upgrades []int32
+	if required.Type != energy.Type && energy.Capacity > 0 {
+		// Changing energy type inserts the new type's plug at the current capacity first.
+		upgrades = append(upgrades, energy.Capacity)
+	}
+	for capacity := energy.Capacity + 1; capacity <= required.Capacity; capacity++ {
+		upgrades = append(upgrades, capacity)
+	}
+
+	for _, capacity := range upgrades {
+		plug, ok := levels[capacity]
+		if !ok {
+			return EnergyPlan{}, EnergyPlanError{fmt.Sprintf("no %s energy capacity plug provides %d energy", required.Type, capacity)}
+		}
+		plan.Upgrades = append(plan.Upgrades, plug.Hash)
+	}
+
+	var err error
+	plan.Materials, err = MaterialCalculator{Items: p.Items, MaterialRequirements: p.MaterialRequirements}.Cost(plan.Upgrades...)
+	if err != nil {
+		return EnergyPlan{}, err
+	}
+	return plan, nil
+}
+
+// energyLevels returns the energy capacity plugs of a type by the capacity they provide. If the armor has an initial
+// energy capacity plug, only plugs of the same category are used, since each kind of armor has its own.
+func (p EnergyPlanner) energyLevels(armor InventoryItemEntity, energyType EnergyType) map[int32]InventoryItemEntity {
+	var category uint32
+	for _, socket := range armor.Sockets.SocketEntries {
+		if plug, ok := p.Items[socket.SingleInitialItemHash]; ok {
+			if _, capacity := p.EnergyTypes.plugCapacity(plug); capacity > 0 {
+				category = plug.Plug.PlugCategoryHash
+				break
+			}
+		}
+	}
+
+	levels := map[int32]InventoryItemEntity{}
+	for _, plug := range p.Items {
+		t, capacity := p.EnergyTypes.plugCapacity(plug)
+		if capacity == 0 || t != energyType || (category != 0 && plug.Plug.PlugCategoryHash != category) {
+			continue
+		}
+		// Prefer the lowest hash so duplicate plugs are chosen consistently.
+		if existing, ok := levels[capacity]; ok && existing.Hash < plug.Hash {
+			continue
+		}
+		levels[capacity] = plug
+	}
+	return levels
+}
@@ -0,0 +1,123 @@
+package destiny2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnergyPlanner(t *testing.T) {
+	const (
+		arcType, voidType = 1000, 2000
+		arcCost, voidCost = 1001, 2001
+		arcCap, voidCap   = 1002, 2002
+		armorCategory     = 50
+		glimmer, module   = 3159615086, 2979281381
+	)
+	energyPlug := func(hash uint32, typeHash, capStat uint32, capacity int32, price uint32) InventoryItemEntity {
+		return InventoryItemEntity{
+			Plug: ItemPlug{
+				PlugCategoryHash:                 armorCategory,
+				EnergyCapacity:                   EnergyCapacityEntry{CapacityValue: 1, EnergyTypeHash: typeHash},
+				InsertionMaterialRequirementHash: price,
+			},
+			InvestmentStats: []ItemInvestmentStat{{StatTypeHash: capStat, Value: capacity}},
+			EntityMetadata:  EntityMetadata{Hash: hash},
+		}
+	}
+	mod := func(hash uint32, typeHash, costStat uint32, cost int32) InventoryItemEntity {
+		return InventoryItemEntity{
+			Plug:            ItemPlug{EnergyCost: EnergyCostEntry{EnergyTypeHash: typeHash}},
+			InvestmentStats: []ItemInvestmentStat{{StatTypeHash: costStat, Value: cost}},
+			EntityMetadata:  EntityMetadata{Hash: hash},
+		}
+	}
+
+	planner := EnergyPlanner{
+		Items: InventoryItemDefinition{
+			104: energyPlug(104, arcType, arcCap, 4, 0),
+			105: energyPlug(105, arcType, arcCap, 5, 1),
+			106: energyPlug(106, arcType, arcCap, 6, 1),
+			107: energyPlug(107, arcType, arcCap, 7, 2),
+			204: energyPlug(204, voidType, voidCap, 4, 3),
+			205: energyPlug(205, voidType, voidCap, 5, 1),
+
+			glimmer: {DisplayProperties: DisplayProperties{Name: "Glimmer"}},
+		},
+		EnergyTypes: EnergyTypeDefinition{
+			arcType:  {EnumValue: EnergyType_Arc, CostStatHash: arcCost, CapacityStatHash: arcCap},
+			voidType: {EnumValue: EnergyType_Void, CostStatHash: voidCost, CapacityStatHash: voidCap},
+		},
+		MaterialRequirements: MaterialRequirementSetDefinition{
+			1: {Materials: []MaterialRequirement{{ItemHash: glimmer, Count: 1000, DeleteOnAction: true}}},
+			2: {Materials: []MaterialRequirement{{ItemHash: glimmer, Count: 2000, DeleteOnAction: true}, {ItemHash: module, Count: 1, DeleteOnAction: true}}},
+			3: {Materials: []MaterialRequirement{{ItemHash: module, Count: 1, DeleteOnAction: true}}},
+		},
+	}
+	armor := InventoryItemEntity{Sockets: ItemSocketBlock{SocketEntries: []ItemSocketEntry{{SingleInitialItemHash: 104}}}}
+	current := ItemEnergy{Type: EnergyType_Arc, Capacity: 4}
+
+	anyMod := InventoryItemEntity{Plug: ItemPlug{EnergyCost: EnergyCostEntry{EnergyCost: 3}}, EntityMetadata: EntityMetadata{Hash: 1}}
+	arcMod := mod(2, arcType, arcCost, 4)
+	voidMod := mod(3, voidType, voidCost, 1)
+
+	tests := []struct {
+		name    string
+		mods    []InventoryItemEntity
+		want    EnergyPlan
+		fits    bool
+		wantErr bool
+	}{
+		{
+			name: "fits",
+			mods: []InventoryItemEntity{anyMod},
+			want: EnergyPlan{Current: ItemEnergy{EnergyType_Arc, 4, 3}, Required: ItemEnergy{EnergyType_Arc, 4, 3}},
+			fits: true,
+		},
+		{
+			name: "upgrade",
+			mods: []InventoryItemEntity{anyMod, arcMod},
+			want: EnergyPlan{
+				Current:   ItemEnergy{EnergyType_Arc, 4, 7},
+				Required:  ItemEnergy{EnergyType_Arc, 7, 7},
+				Upgrades:  []uint32{105, 106, 107},
+				Materials: []MaterialCost{{ItemHash: module, Consumed: 1}, {ItemHash: glimmer, Name: "Glimmer", Consumed: 4000}},
+			},
+		},
+		{
+			name: "change energy type",
+			mods: []InventoryItemEntity{anyMod, voidMod, voidMod},
+			want: EnergyPlan{
+				Current:   ItemEnergy{EnergyType_Arc, 4, 5},
+				Required:  ItemEnergy{EnergyType_Void, 5, 5},
+				Upgrades:  []uint32{204, 205},
+				Materials: []MaterialCost{{ItemHash: module, Consumed: 1}, {ItemHash: glimmer, Name: "Glimmer", Consumed: 1000}},
+			},
+		},
+		{name: "conflicting energy types", mods: []InventoryItemEntity{arcMod, voidMod}, wantErr: true},
+		{name: "too expensive", mods: []InventoryItemEntity{arcMod, arcMod, anyMod}, wantErr: true},
+		// There is no void plug with 6 energy.
+		{name: "no energy plug", mods: []InventoryItemEntity{anyMod, anyMod, voidMod}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := planner.Plan(armor, current, test.mods)
+			if test.wantErr {
+				if !errors.As(err, &EnergyPlanError{}) {
+					t.Errorf("Plan(): got error %v, want EnergyPlanError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Plan() mismatch (-want +got):\n%s", diff)
+			}
+			if got.Fits() != test.fits {
+				t.Errorf("Fits(): got %t, want %t", got.Fits(), test.fits)
+			}
+		})
+	}
+}
@@ -104,6 +104,7 @@ const (
 )
 
 // EquippingItemBlockAttributes are custom attributes on the equippability of the item.
+// This is meant to be used as a bitmask.
 type EquippingItemBlockAttributes int32
 
 const (
@@ -670,6 +671,7 @@ const (
 )
 
 // SocketPlugSources are indications of how a socket is populated, and where to look for valid plug data.
+// This is meant to be used as a bitmask.
 type SocketPlugSources int32
 
 const (
@@ -678,8 +680,8 @@ const (
 	// InventorySourced plugs are found in a player's inventory.
 	SocketPlug_InventorySourced  = 1
 	SocketPlug_ReusablePlugItems = 2
-	SocketPlug_ProfilePlugSet    = 3
-	SocketPlug_CharacterPlugSet  = 4
+	SocketPlug_ProfilePlugSet    = 4
+	SocketPlug_CharacterPlugSet  = 8
 )
 
 // ItemPerkVisibility determines how a perk should be shown in the game UI.
@@ -0,0 +1,2775 @@
+// Code generated by gen_enum.go; DO NOT EDIT.
+
+package destiny2
+
+var bungieMembershipTypeNames = enumNames{
+	{0, "None"},
+	{1, "TigerXbox"},
+	{2, "TigerPSN"},
+	{3, "TigerSteam"},
+	{4, "TigerBlizzard"},
+	{5, "TigerStadia"},
+	{10, "TigerDemon"},
+	{254, "BungieNext"},
+	{-1, "All"},
+}
+
+func (v BungieMembershipType) String() string {
+	return bungieMembershipTypeNames.format(int32(v), "BungieMembershipType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BungieMembershipType) MarshalText() ([]byte, error) {
+	return bungieMembershipTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BungieMembershipType) UnmarshalText(text []byte) error {
+	n, err := bungieMembershipTypeNames.parse(string(text), "BungieMembershipType", false)
+	if err != nil {
+		return err
+	}
+	*v = BungieMembershipType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *BungieMembershipType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := bungieMembershipTypeNames.unmarshalJSON(data, "BungieMembershipType", false)
+	if err != nil {
+		return err
+	}
+	*v = BungieMembershipType(n)
+	return nil
+}
+
+// ParseBungieMembershipType returns the BungieMembershipType with the given name.
+func ParseBungieMembershipType(name string) (BungieMembershipType, error) {
+	n, err := bungieMembershipTypeNames.parse(name, "BungieMembershipType", false)
+	return BungieMembershipType(n), err
+}
+
+var progressionScopeNames = enumNames{
+	{0, "Account"},
+	{1, "Character"},
+	{2, "Clan"},
+	{3, "Item"},
+	{4, "ImplicitFromEquipment"},
+	{5, "Mapped"},
+	{6, "MappedAggregate"},
+	{7, "MappedStat"},
+	{8, "MappedUnlockValue"},
+}
+
+func (v ProgressionScope) String() string {
+	return progressionScopeNames.format(int32(v), "ProgressionScope", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ProgressionScope) MarshalText() ([]byte, error) {
+	return progressionScopeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ProgressionScope) UnmarshalText(text []byte) error {
+	n, err := progressionScopeNames.parse(string(text), "ProgressionScope", false)
+	if err != nil {
+		return err
+	}
+	*v = ProgressionScope(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ProgressionScope) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := progressionScopeNames.unmarshalJSON(data, "ProgressionScope", false)
+	if err != nil {
+		return err
+	}
+	*v = ProgressionScope(n)
+	return nil
+}
+
+// ParseProgressionScope returns the ProgressionScope with the given name.
+func ParseProgressionScope(name string) (ProgressionScope, error) {
+	n, err := progressionScopeNames.parse(name, "ProgressionScope", false)
+	return ProgressionScope(n), err
+}
+
+var progressionStepDisplayEffectNames = enumNames{
+	{0, "None"},
+	{1, "Character"},
+	{2, "Item"},
+}
+
+func (v ProgressionStepDisplayEffect) String() string {
+	return progressionStepDisplayEffectNames.format(int32(v), "ProgressionStepDisplayEffect", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ProgressionStepDisplayEffect) MarshalText() ([]byte, error) {
+	return progressionStepDisplayEffectNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ProgressionStepDisplayEffect) UnmarshalText(text []byte) error {
+	n, err := progressionStepDisplayEffectNames.parse(string(text), "ProgressionStepDisplayEffect", false)
+	if err != nil {
+		return err
+	}
+	*v = ProgressionStepDisplayEffect(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ProgressionStepDisplayEffect) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := progressionStepDisplayEffectNames.unmarshalJSON(data, "ProgressionStepDisplayEffect", false)
+	if err != nil {
+		return err
+	}
+	*v = ProgressionStepDisplayEffect(n)
+	return nil
+}
+
+// ParseProgressionStepDisplayEffect returns the ProgressionStepDisplayEffect with the given name.
+func ParseProgressionStepDisplayEffect(name string) (ProgressionStepDisplayEffect, error) {
+	n, err := progressionStepDisplayEffectNames.parse(name, "ProgressionStepDisplayEffect", false)
+	return ProgressionStepDisplayEffect(n), err
+}
+
+var itemTierNames = enumNames{
+	{0, "Unknown"},
+	{1, "Currency"},
+	{2, "Basic"},
+	{3, "Common"},
+	{4, "Rare"},
+	{5, "Superior"},
+	{6, "Exotic"},
+}
+
+func (v ItemTier) String() string {
+	return itemTierNames.format(int32(v), "ItemTier", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ItemTier) MarshalText() ([]byte, error) {
+	return itemTierNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ItemTier) UnmarshalText(text []byte) error {
+	n, err := itemTierNames.parse(string(text), "ItemTier", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemTier(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ItemTier) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := itemTierNames.unmarshalJSON(data, "ItemTier", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemTier(n)
+	return nil
+}
+
+// ParseItemTier returns the ItemTier with the given name.
+func ParseItemTier(name string) (ItemTier, error) {
+	n, err := itemTierNames.parse(name, "ItemTier", false)
+	return ItemTier(n), err
+}
+
+var bucketScopeNames = enumNames{
+	{0, "Profile"},
+	{1, "Character"},
+}
+
+func (v BucketScope) String() string {
+	return bucketScopeNames.format(int32(v), "BucketScope", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BucketScope) MarshalText() ([]byte, error) {
+	return bucketScopeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BucketScope) UnmarshalText(text []byte) error {
+	n, err := bucketScopeNames.parse(string(text), "BucketScope", false)
+	if err != nil {
+		return err
+	}
+	*v = BucketScope(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *BucketScope) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := bucketScopeNames.unmarshalJSON(data, "BucketScope", false)
+	if err != nil {
+		return err
+	}
+	*v = BucketScope(n)
+	return nil
+}
+
+// ParseBucketScope returns the BucketScope with the given name.
+func ParseBucketScope(name string) (BucketScope, error) {
+	n, err := bucketScopeNames.parse(name, "BucketScope", false)
+	return BucketScope(n), err
+}
+
+var bucketCategoryNames = enumNames{
+	{0, "Invisible"},
+	{1, "Item"},
+	{2, "Currency"},
+	{3, "Equippable"},
+	{4, "Ignored"},
+}
+
+func (v BucketCategory) String() string {
+	return bucketCategoryNames.format(int32(v), "BucketCategory", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BucketCategory) MarshalText() ([]byte, error) {
+	return bucketCategoryNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BucketCategory) UnmarshalText(text []byte) error {
+	n, err := bucketCategoryNames.parse(string(text), "BucketCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = BucketCategory(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *BucketCategory) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := bucketCategoryNames.unmarshalJSON(data, "BucketCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = BucketCategory(n)
+	return nil
+}
+
+// ParseBucketCategory returns the BucketCategory with the given name.
+func ParseBucketCategory(name string) (BucketCategory, error) {
+	n, err := bucketCategoryNames.parse(name, "BucketCategory", false)
+	return BucketCategory(n), err
+}
+
+var itemLocationNames = enumNames{
+	{0, "Unknown"},
+	{1, "Inventory"},
+	{2, "Vault"},
+	{3, "Vendor"},
+	{4, "Postmaster"},
+}
+
+func (v ItemLocation) String() string {
+	return itemLocationNames.format(int32(v), "ItemLocation", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ItemLocation) MarshalText() ([]byte, error) {
+	return itemLocationNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ItemLocation) UnmarshalText(text []byte) error {
+	n, err := itemLocationNames.parse(string(text), "ItemLocation", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemLocation(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ItemLocation) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := itemLocationNames.unmarshalJSON(data, "ItemLocation", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemLocation(n)
+	return nil
+}
+
+// ParseItemLocation returns the ItemLocation with the given name.
+func ParseItemLocation(name string) (ItemLocation, error) {
+	n, err := itemLocationNames.parse(name, "ItemLocation", false)
+	return ItemLocation(n), err
+}
+
+var statAggregationTypeNames = enumNames{
+	{0, "CharacterAverage"},
+	{1, "Character"},
+	{2, "Item"},
+}
+
+func (v StatAggregationType) String() string {
+	return statAggregationTypeNames.format(int32(v), "StatAggregationType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v StatAggregationType) MarshalText() ([]byte, error) {
+	return statAggregationTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *StatAggregationType) UnmarshalText(text []byte) error {
+	n, err := statAggregationTypeNames.parse(string(text), "StatAggregationType", false)
+	if err != nil {
+		return err
+	}
+	*v = StatAggregationType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *StatAggregationType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := statAggregationTypeNames.unmarshalJSON(data, "StatAggregationType", false)
+	if err != nil {
+		return err
+	}
+	*v = StatAggregationType(n)
+	return nil
+}
+
+// ParseStatAggregationType returns the StatAggregationType with the given name.
+func ParseStatAggregationType(name string) (StatAggregationType, error) {
+	n, err := statAggregationTypeNames.parse(name, "StatAggregationType", false)
+	return StatAggregationType(n), err
+}
+
+var statCategoryNames = enumNames{
+	{0, "Gameplay"},
+	{1, "Weapon"},
+	{2, "Defense"},
+	{3, "Primary"},
+}
+
+func (v StatCategory) String() string {
+	return statCategoryNames.format(int32(v), "StatCategory", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v StatCategory) MarshalText() ([]byte, error) {
+	return statCategoryNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *StatCategory) UnmarshalText(text []byte) error {
+	n, err := statCategoryNames.parse(string(text), "StatCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = StatCategory(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *StatCategory) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := statCategoryNames.unmarshalJSON(data, "StatCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = StatCategory(n)
+	return nil
+}
+
+// ParseStatCategory returns the StatCategory with the given name.
+func ParseStatCategory(name string) (StatCategory, error) {
+	n, err := statCategoryNames.parse(name, "StatCategory", false)
+	return StatCategory(n), err
+}
+
+var equippingItemBlockAttributesNames = enumNames{
+	{0, "None"},
+	{1, "EquipOnAcquire"},
+}
+
+func (v EquippingItemBlockAttributes) String() string {
+	return equippingItemBlockAttributesNames.format(int32(v), "EquippingItemBlockAttributes", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v EquippingItemBlockAttributes) MarshalText() ([]byte, error) {
+	return equippingItemBlockAttributesNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *EquippingItemBlockAttributes) UnmarshalText(text []byte) error {
+	n, err := equippingItemBlockAttributesNames.parse(string(text), "EquippingItemBlockAttributes", true)
+	if err != nil {
+		return err
+	}
+	*v = EquippingItemBlockAttributes(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *EquippingItemBlockAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := equippingItemBlockAttributesNames.unmarshalJSON(data, "EquippingItemBlockAttributes", true)
+	if err != nil {
+		return err
+	}
+	*v = EquippingItemBlockAttributes(n)
+	return nil
+}
+
+// ParseEquippingItemBlockAttributes returns the EquippingItemBlockAttributes with the given name.
+func ParseEquippingItemBlockAttributes(name string) (EquippingItemBlockAttributes, error) {
+	n, err := equippingItemBlockAttributesNames.parse(name, "EquippingItemBlockAttributes", true)
+	return EquippingItemBlockAttributes(n), err
+}
+
+var ammunitionTypeNames = enumNames{
+	{0, "None"},
+	{1, "Primary"},
+	{2, "Special"},
+	{3, "Heavy"},
+	{4, "Unknown"},
+}
+
+func (v AmmunitionType) String() string {
+	return ammunitionTypeNames.format(int32(v), "AmmunitionType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v AmmunitionType) MarshalText() ([]byte, error) {
+	return ammunitionTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *AmmunitionType) UnmarshalText(text []byte) error {
+	n, err := ammunitionTypeNames.parse(string(text), "AmmunitionType", false)
+	if err != nil {
+		return err
+	}
+	*v = AmmunitionType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *AmmunitionType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := ammunitionTypeNames.unmarshalJSON(data, "AmmunitionType", false)
+	if err != nil {
+		return err
+	}
+	*v = AmmunitionType(n)
+	return nil
+}
+
+// ParseAmmunitionType returns the AmmunitionType with the given name.
+func ParseAmmunitionType(name string) (AmmunitionType, error) {
+	n, err := ammunitionTypeNames.parse(name, "AmmunitionType", false)
+	return AmmunitionType(n), err
+}
+
+var vendorProgressionTypeNames = enumNames{
+	{0, "Default"},
+	{1, "Ritual"},
+	{2, "NoSeasonalRefresh"},
+}
+
+func (v VendorProgressionType) String() string {
+	return vendorProgressionTypeNames.format(int32(v), "VendorProgressionType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VendorProgressionType) MarshalText() ([]byte, error) {
+	return vendorProgressionTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VendorProgressionType) UnmarshalText(text []byte) error {
+	n, err := vendorProgressionTypeNames.parse(string(text), "VendorProgressionType", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorProgressionType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *VendorProgressionType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := vendorProgressionTypeNames.unmarshalJSON(data, "VendorProgressionType", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorProgressionType(n)
+	return nil
+}
+
+// ParseVendorProgressionType returns the VendorProgressionType with the given name.
+func ParseVendorProgressionType(name string) (VendorProgressionType, error) {
+	n, err := vendorProgressionTypeNames.parse(name, "VendorProgressionType", false)
+	return VendorProgressionType(n), err
+}
+
+var vendorInteractionRewardSelectionNames = enumNames{
+	{0, "None"},
+	{1, "One"},
+	{2, "All"},
+}
+
+func (v VendorInteractionRewardSelection) String() string {
+	return vendorInteractionRewardSelectionNames.format(int32(v), "VendorInteractionRewardSelection", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VendorInteractionRewardSelection) MarshalText() ([]byte, error) {
+	return vendorInteractionRewardSelectionNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VendorInteractionRewardSelection) UnmarshalText(text []byte) error {
+	n, err := vendorInteractionRewardSelectionNames.parse(string(text), "VendorInteractionRewardSelection", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorInteractionRewardSelection(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *VendorInteractionRewardSelection) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := vendorInteractionRewardSelectionNames.unmarshalJSON(data, "VendorInteractionRewardSelection", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorInteractionRewardSelection(n)
+	return nil
+}
+
+// ParseVendorInteractionRewardSelection returns the VendorInteractionRewardSelection with the given name.
+func ParseVendorInteractionRewardSelection(name string) (VendorInteractionRewardSelection, error) {
+	n, err := vendorInteractionRewardSelectionNames.parse(name, "VendorInteractionRewardSelection", false)
+	return VendorInteractionRewardSelection(n), err
+}
+
+var vendorReplyTypeNames = enumNames{
+	{0, "Accept"},
+	{1, "Decline"},
+	{2, "Complete"},
+}
+
+func (v VendorReplyType) String() string {
+	return vendorReplyTypeNames.format(int32(v), "VendorReplyType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VendorReplyType) MarshalText() ([]byte, error) {
+	return vendorReplyTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VendorReplyType) UnmarshalText(text []byte) error {
+	n, err := vendorReplyTypeNames.parse(string(text), "VendorReplyType", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorReplyType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *VendorReplyType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := vendorReplyTypeNames.unmarshalJSON(data, "VendorReplyType", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorReplyType(n)
+	return nil
+}
+
+// ParseVendorReplyType returns the VendorReplyType with the given name.
+func ParseVendorReplyType(name string) (VendorReplyType, error) {
+	n, err := vendorReplyTypeNames.parse(name, "VendorReplyType", false)
+	return VendorReplyType(n), err
+}
+
+var vendorInteractionTypeNames = enumNames{
+	{0, "Unknown"},
+	{1, "Undefined"},
+	{2, "QuestComplete"},
+	{3, "QuestContinue"},
+	{4, "ReputationPreview"},
+	{5, "RankUpReward"},
+	{6, "TokenTurnin"},
+	{7, "QuestAccept"},
+	{8, "ProgressTab"},
+	{9, "End"},
+	{10, "Start"},
+}
+
+func (v VendorInteractionType) String() string {
+	return vendorInteractionTypeNames.format(int32(v), "VendorInteractionType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VendorInteractionType) MarshalText() ([]byte, error) {
+	return vendorInteractionTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VendorInteractionType) UnmarshalText(text []byte) error {
+	n, err := vendorInteractionTypeNames.parse(string(text), "VendorInteractionType", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorInteractionType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *VendorInteractionType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := vendorInteractionTypeNames.unmarshalJSON(data, "VendorInteractionType", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorInteractionType(n)
+	return nil
+}
+
+// ParseVendorInteractionType returns the VendorInteractionType with the given name.
+func ParseVendorInteractionType(name string) (VendorInteractionType, error) {
+	n, err := vendorInteractionTypeNames.parse(name, "VendorInteractionType", false)
+	return VendorInteractionType(n), err
+}
+
+var vendorItemRefundPolicyNames = enumNames{
+	{0, "NotRefundable"},
+	{1, "DeletesItem"},
+	{2, "RevokesLicense"},
+}
+
+func (v VendorItemRefundPolicy) String() string {
+	return vendorItemRefundPolicyNames.format(int32(v), "VendorItemRefundPolicy", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v VendorItemRefundPolicy) MarshalText() ([]byte, error) {
+	return vendorItemRefundPolicyNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *VendorItemRefundPolicy) UnmarshalText(text []byte) error {
+	n, err := vendorItemRefundPolicyNames.parse(string(text), "VendorItemRefundPolicy", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorItemRefundPolicy(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *VendorItemRefundPolicy) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := vendorItemRefundPolicyNames.unmarshalJSON(data, "VendorItemRefundPolicy", false)
+	if err != nil {
+		return err
+	}
+	*v = VendorItemRefundPolicy(n)
+	return nil
+}
+
+// ParseVendorItemRefundPolicy returns the VendorItemRefundPolicy with the given name.
+func ParseVendorItemRefundPolicy(name string) (VendorItemRefundPolicy, error) {
+	n, err := vendorItemRefundPolicyNames.parse(name, "VendorItemRefundPolicy", false)
+	return VendorItemRefundPolicy(n), err
+}
+
+var gatingScopeNames = enumNames{
+	{0, "None"},
+	{1, "Global"},
+	{2, "Clan"},
+	{3, "Profile"},
+	{4, "Character"},
+	{5, "Item"},
+	{6, "AssumedWorstCase"},
+}
+
+func (v GatingScope) String() string {
+	return gatingScopeNames.format(int32(v), "GatingScope", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GatingScope) MarshalText() ([]byte, error) {
+	return gatingScopeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GatingScope) UnmarshalText(text []byte) error {
+	n, err := gatingScopeNames.parse(string(text), "GatingScope", false)
+	if err != nil {
+		return err
+	}
+	*v = GatingScope(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *GatingScope) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := gatingScopeNames.unmarshalJSON(data, "GatingScope", false)
+	if err != nil {
+		return err
+	}
+	*v = GatingScope(n)
+	return nil
+}
+
+// ParseGatingScope returns the GatingScope with the given name.
+func ParseGatingScope(name string) (GatingScope, error) {
+	n, err := gatingScopeNames.parse(name, "GatingScope", false)
+	return GatingScope(n), err
+}
+
+var socketTypeActionTypeNames = enumNames{
+	{0, "InsertPlug"},
+	{1, "InfuseItem"},
+	{2, "ReinitializeSocket"},
+}
+
+func (v SocketTypeActionType) String() string {
+	return socketTypeActionTypeNames.format(int32(v), "SocketTypeActionType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v SocketTypeActionType) MarshalText() ([]byte, error) {
+	return socketTypeActionTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *SocketTypeActionType) UnmarshalText(text []byte) error {
+	n, err := socketTypeActionTypeNames.parse(string(text), "SocketTypeActionType", false)
+	if err != nil {
+		return err
+	}
+	*v = SocketTypeActionType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *SocketTypeActionType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := socketTypeActionTypeNames.unmarshalJSON(data, "SocketTypeActionType", false)
+	if err != nil {
+		return err
+	}
+	*v = SocketTypeActionType(n)
+	return nil
+}
+
+// ParseSocketTypeActionType returns the SocketTypeActionType with the given name.
+func ParseSocketTypeActionType(name string) (SocketTypeActionType, error) {
+	n, err := socketTypeActionTypeNames.parse(name, "SocketTypeActionType", false)
+	return SocketTypeActionType(n), err
+}
+
+var socketVisibilityNames = enumNames{
+	{0, "Visible"},
+	{1, "Hidden"},
+	{2, "HiddenWhenEmpty"},
+	{3, "HiddenIfNoPlugsAvailable"},
+}
+
+func (v SocketVisibility) String() string {
+	return socketVisibilityNames.format(int32(v), "SocketVisibility", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v SocketVisibility) MarshalText() ([]byte, error) {
+	return socketVisibilityNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *SocketVisibility) UnmarshalText(text []byte) error {
+	n, err := socketVisibilityNames.parse(string(text), "SocketVisibility", false)
+	if err != nil {
+		return err
+	}
+	*v = SocketVisibility(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *SocketVisibility) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := socketVisibilityNames.unmarshalJSON(data, "SocketVisibility", false)
+	if err != nil {
+		return err
+	}
+	*v = SocketVisibility(n)
+	return nil
+}
+
+// ParseSocketVisibility returns the SocketVisibility with the given name.
+func ParseSocketVisibility(name string) (SocketVisibility, error) {
+	n, err := socketVisibilityNames.parse(name, "SocketVisibility", false)
+	return SocketVisibility(n), err
+}
+
+var socketCategoryStyleNames = enumNames{
+	{0, "Unknown"},
+	{1, "Reusable"},
+	{2, "Consumable"},
+	{3, "Unlockable"},
+	{4, "Intrinsic"},
+	{5, "EnergyMeter"},
+	{6, "LargePerk"},
+	{7, "Abilities"},
+	{8, "Supers"},
+}
+
+func (v SocketCategoryStyle) String() string {
+	return socketCategoryStyleNames.format(int32(v), "SocketCategoryStyle", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v SocketCategoryStyle) MarshalText() ([]byte, error) {
+	return socketCategoryStyleNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *SocketCategoryStyle) UnmarshalText(text []byte) error {
+	n, err := socketCategoryStyleNames.parse(string(text), "SocketCategoryStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = SocketCategoryStyle(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *SocketCategoryStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := socketCategoryStyleNames.unmarshalJSON(data, "SocketCategoryStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = SocketCategoryStyle(n)
+	return nil
+}
+
+// ParseSocketCategoryStyle returns the SocketCategoryStyle with the given name.
+func ParseSocketCategoryStyle(name string) (SocketCategoryStyle, error) {
+	n, err := socketCategoryStyleNames.parse(name, "SocketCategoryStyle", false)
+	return SocketCategoryStyle(n), err
+}
+
+var activityGraphNodeHighlightTypeNames = enumNames{
+	{0, "None"},
+	{1, "Normal"},
+	{2, "Hyper"},
+	{3, "Comet"},
+	{4, "RiseOfIron"},
+}
+
+func (v ActivityGraphNodeHighlightType) String() string {
+	return activityGraphNodeHighlightTypeNames.format(int32(v), "ActivityGraphNodeHighlightType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ActivityGraphNodeHighlightType) MarshalText() ([]byte, error) {
+	return activityGraphNodeHighlightTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ActivityGraphNodeHighlightType) UnmarshalText(text []byte) error {
+	n, err := activityGraphNodeHighlightTypeNames.parse(string(text), "ActivityGraphNodeHighlightType", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityGraphNodeHighlightType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ActivityGraphNodeHighlightType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := activityGraphNodeHighlightTypeNames.unmarshalJSON(data, "ActivityGraphNodeHighlightType", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityGraphNodeHighlightType(n)
+	return nil
+}
+
+// ParseActivityGraphNodeHighlightType returns the ActivityGraphNodeHighlightType with the given name.
+func ParseActivityGraphNodeHighlightType(name string) (ActivityGraphNodeHighlightType, error) {
+	n, err := activityGraphNodeHighlightTypeNames.parse(name, "ActivityGraphNodeHighlightType", false)
+	return ActivityGraphNodeHighlightType(n), err
+}
+
+var unlockValueUIStyleNames = enumNames{
+	{0, "Automatic"},
+	{1, "Fraction"},
+	{2, "Checkbox"},
+	{3, "Percentage"},
+	{4, "DateTime"},
+	{5, "FractionFloat"},
+	{6, "Integer"},
+	{7, "TimeDuration"},
+	{8, "Hidden"},
+	{9, "Multiplier"},
+	{10, "GreenPips"},
+	{11, "RedPips"},
+	{12, "ExplicitPercentage"},
+	{13, "RawFloat"},
+}
+
+func (v UnlockValueUIStyle) String() string {
+	return unlockValueUIStyleNames.format(int32(v), "UnlockValueUIStyle", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v UnlockValueUIStyle) MarshalText() ([]byte, error) {
+	return unlockValueUIStyleNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *UnlockValueUIStyle) UnmarshalText(text []byte) error {
+	n, err := unlockValueUIStyleNames.parse(string(text), "UnlockValueUIStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = UnlockValueUIStyle(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *UnlockValueUIStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := unlockValueUIStyleNames.unmarshalJSON(data, "UnlockValueUIStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = UnlockValueUIStyle(n)
+	return nil
+}
+
+// ParseUnlockValueUIStyle returns the UnlockValueUIStyle with the given name.
+func ParseUnlockValueUIStyle(name string) (UnlockValueUIStyle, error) {
+	n, err := unlockValueUIStyleNames.parse(name, "UnlockValueUIStyle", false)
+	return UnlockValueUIStyle(n), err
+}
+
+var objectiveGrantStyleNames = enumNames{
+	{0, "WhenIncomplete"},
+	{1, "WhenComplete"},
+	{2, "Always"},
+}
+
+func (v ObjectiveGrantStyle) String() string {
+	return objectiveGrantStyleNames.format(int32(v), "ObjectiveGrantStyle", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ObjectiveGrantStyle) MarshalText() ([]byte, error) {
+	return objectiveGrantStyleNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ObjectiveGrantStyle) UnmarshalText(text []byte) error {
+	n, err := objectiveGrantStyleNames.parse(string(text), "ObjectiveGrantStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = ObjectiveGrantStyle(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ObjectiveGrantStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := objectiveGrantStyleNames.unmarshalJSON(data, "ObjectiveGrantStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = ObjectiveGrantStyle(n)
+	return nil
+}
+
+// ParseObjectiveGrantStyle returns the ObjectiveGrantStyle with the given name.
+func ParseObjectiveGrantStyle(name string) (ObjectiveGrantStyle, error) {
+	n, err := objectiveGrantStyleNames.parse(name, "ObjectiveGrantStyle", false)
+	return ObjectiveGrantStyle(n), err
+}
+
+var damageTypeNames = enumNames{
+	{0, "None"},
+	{1, "Kinetic"},
+	{2, "Arc"},
+	{3, "Thermal"},
+	{4, "Void"},
+	{5, "Raid"},
+	{6, "Stasis"},
+}
+
+func (v DamageType) String() string {
+	return damageTypeNames.format(int32(v), "DamageType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v DamageType) MarshalText() ([]byte, error) {
+	return damageTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *DamageType) UnmarshalText(text []byte) error {
+	n, err := damageTypeNames.parse(string(text), "DamageType", false)
+	if err != nil {
+		return err
+	}
+	*v = DamageType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *DamageType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := damageTypeNames.unmarshalJSON(data, "DamageType", false)
+	if err != nil {
+		return err
+	}
+	*v = DamageType(n)
+	return nil
+}
+
+// ParseDamageType returns the DamageType with the given name.
+func ParseDamageType(name string) (DamageType, error) {
+	n, err := damageTypeNames.parse(name, "DamageType", false)
+	return DamageType(n), err
+}
+
+var talentNodeStepWeaponPerformancesNames = enumNames{
+	{0, "None"},
+	{1, "RateOfFire"},
+	{2, "Damage"},
+	{4, "Accuracy"},
+	{8, "Range"},
+	{16, "Zoom"},
+	{32, "Recoil"},
+	{64, "Ready"},
+	{128, "Reload"},
+	{256, "HairTrigger"},
+	{512, "AmmoAndMagazine"},
+	{1024, "TrackingAndDetonation"},
+	{2048, "ShotgunSpread"},
+	{4096, "ChargeTime"},
+	{8191, "All"},
+}
+
+func (v TalentNodeStepWeaponPerformances) String() string {
+	return talentNodeStepWeaponPerformancesNames.format(int32(v), "TalentNodeStepWeaponPerformances", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v TalentNodeStepWeaponPerformances) MarshalText() ([]byte, error) {
+	return talentNodeStepWeaponPerformancesNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *TalentNodeStepWeaponPerformances) UnmarshalText(text []byte) error {
+	n, err := talentNodeStepWeaponPerformancesNames.parse(string(text), "TalentNodeStepWeaponPerformances", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepWeaponPerformances(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *TalentNodeStepWeaponPerformances) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := talentNodeStepWeaponPerformancesNames.unmarshalJSON(data, "TalentNodeStepWeaponPerformances", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepWeaponPerformances(n)
+	return nil
+}
+
+// ParseTalentNodeStepWeaponPerformances returns the TalentNodeStepWeaponPerformances with the given name.
+func ParseTalentNodeStepWeaponPerformances(name string) (TalentNodeStepWeaponPerformances, error) {
+	n, err := talentNodeStepWeaponPerformancesNames.parse(name, "TalentNodeStepWeaponPerformances", true)
+	return TalentNodeStepWeaponPerformances(n), err
+}
+
+var talentNodeStepImpactEffectsNames = enumNames{
+	{0, "None"},
+	{1, "ArmorPiercing"},
+	{2, "Ricochet"},
+	{4, "Flinch"},
+	{8, "CollateralDamage"},
+	{16, "Disorient"},
+	{32, "HighlightTarget"},
+	{63, "All"},
+}
+
+func (v TalentNodeStepImpactEffects) String() string {
+	return talentNodeStepImpactEffectsNames.format(int32(v), "TalentNodeStepImpactEffects", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v TalentNodeStepImpactEffects) MarshalText() ([]byte, error) {
+	return talentNodeStepImpactEffectsNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *TalentNodeStepImpactEffects) UnmarshalText(text []byte) error {
+	n, err := talentNodeStepImpactEffectsNames.parse(string(text), "TalentNodeStepImpactEffects", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepImpactEffects(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *TalentNodeStepImpactEffects) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := talentNodeStepImpactEffectsNames.unmarshalJSON(data, "TalentNodeStepImpactEffects", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepImpactEffects(n)
+	return nil
+}
+
+// ParseTalentNodeStepImpactEffects returns the TalentNodeStepImpactEffects with the given name.
+func ParseTalentNodeStepImpactEffects(name string) (TalentNodeStepImpactEffects, error) {
+	n, err := talentNodeStepImpactEffectsNames.parse(name, "TalentNodeStepImpactEffects", true)
+	return TalentNodeStepImpactEffects(n), err
+}
+
+var talentNodeStepGuardianAttributesNames = enumNames{
+	{0, "None"},
+	{1, "Stats"},
+	{2, "Shields"},
+	{4, "Health"},
+	{8, "Revive"},
+	{16, "AimUnderFire"},
+	{32, "Radar"},
+	{64, "Invisibility"},
+	{128, "Reputations"},
+	{255, "All"},
+}
+
+func (v TalentNodeStepGuardianAttributes) String() string {
+	return talentNodeStepGuardianAttributesNames.format(int32(v), "TalentNodeStepGuardianAttributes", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v TalentNodeStepGuardianAttributes) MarshalText() ([]byte, error) {
+	return talentNodeStepGuardianAttributesNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *TalentNodeStepGuardianAttributes) UnmarshalText(text []byte) error {
+	n, err := talentNodeStepGuardianAttributesNames.parse(string(text), "TalentNodeStepGuardianAttributes", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepGuardianAttributes(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *TalentNodeStepGuardianAttributes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := talentNodeStepGuardianAttributesNames.unmarshalJSON(data, "TalentNodeStepGuardianAttributes", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepGuardianAttributes(n)
+	return nil
+}
+
+// ParseTalentNodeStepGuardianAttributes returns the TalentNodeStepGuardianAttributes with the given name.
+func ParseTalentNodeStepGuardianAttributes(name string) (TalentNodeStepGuardianAttributes, error) {
+	n, err := talentNodeStepGuardianAttributesNames.parse(name, "TalentNodeStepGuardianAttributes", true)
+	return TalentNodeStepGuardianAttributes(n), err
+}
+
+var talentNodeStepLightAbilitiesNames = enumNames{
+	{0, "None"},
+	{1, "Grenades"},
+	{2, "Melee"},
+	{4, "MovementModes"},
+	{8, "Orbs"},
+	{16, "SuperEnergy"},
+	{32, "SuperMods"},
+	{63, "All"},
+}
+
+func (v TalentNodeStepLightAbilities) String() string {
+	return talentNodeStepLightAbilitiesNames.format(int32(v), "TalentNodeStepLightAbilities", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v TalentNodeStepLightAbilities) MarshalText() ([]byte, error) {
+	return talentNodeStepLightAbilitiesNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *TalentNodeStepLightAbilities) UnmarshalText(text []byte) error {
+	n, err := talentNodeStepLightAbilitiesNames.parse(string(text), "TalentNodeStepLightAbilities", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepLightAbilities(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *TalentNodeStepLightAbilities) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := talentNodeStepLightAbilitiesNames.unmarshalJSON(data, "TalentNodeStepLightAbilities", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepLightAbilities(n)
+	return nil
+}
+
+// ParseTalentNodeStepLightAbilities returns the TalentNodeStepLightAbilities with the given name.
+func ParseTalentNodeStepLightAbilities(name string) (TalentNodeStepLightAbilities, error) {
+	n, err := talentNodeStepLightAbilitiesNames.parse(name, "TalentNodeStepLightAbilities", true)
+	return TalentNodeStepLightAbilities(n), err
+}
+
+var talentNodeStepDamageTypesNames = enumNames{
+	{0, "None"},
+	{1, "Kinetic"},
+	{2, "Arc"},
+	{4, "Solar"},
+	{8, "Void"},
+	{15, "All"},
+}
+
+func (v TalentNodeStepDamageTypes) String() string {
+	return talentNodeStepDamageTypesNames.format(int32(v), "TalentNodeStepDamageTypes", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v TalentNodeStepDamageTypes) MarshalText() ([]byte, error) {
+	return talentNodeStepDamageTypesNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *TalentNodeStepDamageTypes) UnmarshalText(text []byte) error {
+	n, err := talentNodeStepDamageTypesNames.parse(string(text), "TalentNodeStepDamageTypes", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepDamageTypes(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *TalentNodeStepDamageTypes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := talentNodeStepDamageTypesNames.unmarshalJSON(data, "TalentNodeStepDamageTypes", true)
+	if err != nil {
+		return err
+	}
+	*v = TalentNodeStepDamageTypes(n)
+	return nil
+}
+
+// ParseTalentNodeStepDamageTypes returns the TalentNodeStepDamageTypes with the given name.
+func ParseTalentNodeStepDamageTypes(name string) (TalentNodeStepDamageTypes, error) {
+	n, err := talentNodeStepDamageTypesNames.parse(name, "TalentNodeStepDamageTypes", true)
+	return TalentNodeStepDamageTypes(n), err
+}
+
+var activityModeTypeNames = enumNames{
+	{0, "None"},
+	{2, "Story"},
+	{3, "Strike"},
+	{4, "Raid"},
+	{5, "AllPvP"},
+	{6, "Patrol"},
+	{7, "AllPvE"},
+	{9, "Reserved9"},
+	{10, "Control"},
+	{11, "Reserved11"},
+	{12, "Clash"},
+	{13, "Reserved13"},
+	{15, "CrimsonDoubles"},
+	{16, "Nightfall"},
+	{17, "HeroicNightfall"},
+	{18, "AllStrikes"},
+	{19, "IronBanner"},
+	{20, "Reserved20"},
+	{21, "Reserved21"},
+	{22, "Reserved22"},
+	{24, "Reserved24"},
+	{25, "AllMayhem"},
+	{31, "Supremacy"},
+	{26, "Reserved26"},
+	{27, "Reserved27"},
+	{28, "Reserved28"},
+	{29, "Reserved29"},
+	{30, "Reserved30"},
+	{32, "PrivateMatchesAll"},
+	{37, "Survival"},
+	{38, "Countdown"},
+	{39, "TrialsOfTheNine"},
+	{40, "Social"},
+	{41, "TrialsCountdown"},
+	{42, "TrialsSurvival"},
+	{43, "IronBannerControl"},
+	{44, "IronBannerClash"},
+	{45, "IronBannerSupremacy"},
+	{46, "ScoredNightfall"},
+	{47, "ScoredHeroicNightfall"},
+	{48, "Rumble"},
+	{49, "AllDoubles"},
+	{50, "Doubles"},
+	{51, "PrivateMatchesClash"},
+	{52, "PrivateMatchesControl"},
+	{53, "PrivateMatchesSupremacy"},
+	{54, "PrivateMatchesCountdown"},
+	{55, "PrivateMatchesSurvival"},
+	{56, "PrivateMatchesMayhem"},
+	{57, "PrivateMatchesRumble"},
+	{58, "HeroicAdventure"},
+	{59, "Showdown"},
+	{60, "Lockdown"},
+	{61, "Scorched"},
+	{62, "ScorchedTeam"},
+	{63, "Gambit"},
+	{64, "AllPvEEcompetitive"},
+	{65, "Breakthrough"},
+	{66, "BlackArmoryRun"},
+	{67, "Salvage"},
+	{68, "IronBannerSalvage"},
+	{69, "PvPCompetitive"},
+	{70, "PvPQuickplay"},
+	{71, "ClashQuickplay"},
+	{72, "ClashCompetitive"},
+	{73, "ControlQuickplay"},
+	{74, "ControlCompetitive"},
+	{75, "GambitPrime"},
+	{76, "Reckoning"},
+	{77, "Menagerie"},
+	{78, "VexOffensive"},
+	{79, "NightmareHunt"},
+	{80, "Elimination"},
+	{81, "Momentum"},
+	{82, "Dungeon"},
+	{83, "Sundial"},
+	{84, "TrialsOfOsiris"},
+	{85, "Dares"},
+}
+
+func (v ActivityModeType) String() string {
+	return activityModeTypeNames.format(int32(v), "ActivityModeType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ActivityModeType) MarshalText() ([]byte, error) {
+	return activityModeTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ActivityModeType) UnmarshalText(text []byte) error {
+	n, err := activityModeTypeNames.parse(string(text), "ActivityModeType", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityModeType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ActivityModeType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := activityModeTypeNames.unmarshalJSON(data, "ActivityModeType", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityModeType(n)
+	return nil
+}
+
+// ParseActivityModeType returns the ActivityModeType with the given name.
+func ParseActivityModeType(name string) (ActivityModeType, error) {
+	n, err := activityModeTypeNames.parse(name, "ActivityModeType", false)
+	return ActivityModeType(n), err
+}
+
+var activityNavPointTypeNames = enumNames{
+	{0, "Inactive"},
+	{1, "PrimaryObjective"},
+	{2, "SecondaryObjective"},
+	{3, "TravelObjective"},
+	{4, "PublicEventObjective"},
+	{5, "AmmoCache"},
+	{6, "PointTypeFlag"},
+	{7, "CapturePoint"},
+	{8, "DefensiveEncounter"},
+	{9, "GhostInteraction"},
+	{10, "KillAI"},
+	{11, "QuestItem"},
+	{12, "PatrolMission"},
+	{13, "Incoming"},
+	{14, "ArenaObjective"},
+	{15, "AutomationHint"},
+	{16, "TrackedQuest"},
+}
+
+func (v ActivityNavPointType) String() string {
+	return activityNavPointTypeNames.format(int32(v), "ActivityNavPointType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ActivityNavPointType) MarshalText() ([]byte, error) {
+	return activityNavPointTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ActivityNavPointType) UnmarshalText(text []byte) error {
+	n, err := activityNavPointTypeNames.parse(string(text), "ActivityNavPointType", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityNavPointType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ActivityNavPointType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := activityNavPointTypeNames.unmarshalJSON(data, "ActivityNavPointType", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityNavPointType(n)
+	return nil
+}
+
+// ParseActivityNavPointType returns the ActivityNavPointType with the given name.
+func ParseActivityNavPointType(name string) (ActivityNavPointType, error) {
+	n, err := activityNavPointTypeNames.parse(name, "ActivityNavPointType", false)
+	return ActivityNavPointType(n), err
+}
+
+var activityModeCategoryNames = enumNames{
+	{0, "None"},
+	{1, "PvE"},
+	{2, "PvP"},
+	{3, "PvECompetitive"},
+}
+
+func (v ActivityModeCategory) String() string {
+	return activityModeCategoryNames.format(int32(v), "ActivityModeCategory", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ActivityModeCategory) MarshalText() ([]byte, error) {
+	return activityModeCategoryNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ActivityModeCategory) UnmarshalText(text []byte) error {
+	n, err := activityModeCategoryNames.parse(string(text), "ActivityModeCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityModeCategory(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ActivityModeCategory) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := activityModeCategoryNames.unmarshalJSON(data, "ActivityModeCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityModeCategory(n)
+	return nil
+}
+
+// ParseActivityModeCategory returns the ActivityModeCategory with the given name.
+func ParseActivityModeCategory(name string) (ActivityModeCategory, error) {
+	n, err := activityModeCategoryNames.parse(name, "ActivityModeCategory", false)
+	return ActivityModeCategory(n), err
+}
+
+var itemSubTypeNames = enumNames{
+	{0, "None"},
+	{1, "Crucible"},
+	{2, "Vanguard"},
+	{5, "Exotic"},
+	{6, "AutoRifle"},
+	{7, "Shotgun"},
+	{8, "Machinegun"},
+	{9, "HandCannon"},
+	{10, "RocketLauncher"},
+	{11, "FusionRifle"},
+	{12, "SniperRifle"},
+	{13, "PulseRifle"},
+	{14, "ScoutRifle"},
+	{16, "CRM"},
+	{17, "Sidearm"},
+	{18, "Sword"},
+	{19, "Mask"},
+	{20, "Shader"},
+	{21, "Ornament"},
+	{22, "FusionRifleLine"},
+	{23, "GrenadeLauncher"},
+	{24, "SubmachineGun"},
+	{25, "TraceRifle"},
+	{26, "HelmetArmor"},
+	{27, "GauntletsArmor"},
+	{28, "ChestArmor"},
+	{29, "LegArmor"},
+	{30, "ClassArmor"},
+	{31, "Bow"},
+	{32, "DummyRepeatableBounty"},
+}
+
+func (v ItemSubType) String() string {
+	return itemSubTypeNames.format(int32(v), "ItemSubType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ItemSubType) MarshalText() ([]byte, error) {
+	return itemSubTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ItemSubType) UnmarshalText(text []byte) error {
+	n, err := itemSubTypeNames.parse(string(text), "ItemSubType", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemSubType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ItemSubType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := itemSubTypeNames.unmarshalJSON(data, "ItemSubType", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemSubType(n)
+	return nil
+}
+
+// ParseItemSubType returns the ItemSubType with the given name.
+func ParseItemSubType(name string) (ItemSubType, error) {
+	n, err := itemSubTypeNames.parse(name, "ItemSubType", false)
+	return ItemSubType(n), err
+}
+
+var graphNodeStateNames = enumNames{
+	{0, "None"},
+	{1, "Visible"},
+	{2, "Teaser"},
+	{3, "Incomplete"},
+	{4, "Completed"},
+}
+
+func (v GraphNodeState) String() string {
+	return graphNodeStateNames.format(int32(v), "GraphNodeState", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v GraphNodeState) MarshalText() ([]byte, error) {
+	return graphNodeStateNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *GraphNodeState) UnmarshalText(text []byte) error {
+	n, err := graphNodeStateNames.parse(string(text), "GraphNodeState", false)
+	if err != nil {
+		return err
+	}
+	*v = GraphNodeState(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *GraphNodeState) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := graphNodeStateNames.unmarshalJSON(data, "GraphNodeState", false)
+	if err != nil {
+		return err
+	}
+	*v = GraphNodeState(n)
+	return nil
+}
+
+// ParseGraphNodeState returns the GraphNodeState with the given name.
+func ParseGraphNodeState(name string) (GraphNodeState, error) {
+	n, err := graphNodeStateNames.parse(name, "GraphNodeState", false)
+	return GraphNodeState(n), err
+}
+
+var rewardSourceCategoryNames = enumNames{
+	{0, "None"},
+	{1, "Activity"},
+	{2, "Vendor"},
+	{3, "Aggregate"},
+}
+
+func (v RewardSourceCategory) String() string {
+	return rewardSourceCategoryNames.format(int32(v), "RewardSourceCategory", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v RewardSourceCategory) MarshalText() ([]byte, error) {
+	return rewardSourceCategoryNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *RewardSourceCategory) UnmarshalText(text []byte) error {
+	n, err := rewardSourceCategoryNames.parse(string(text), "RewardSourceCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = RewardSourceCategory(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *RewardSourceCategory) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := rewardSourceCategoryNames.unmarshalJSON(data, "RewardSourceCategory", false)
+	if err != nil {
+		return err
+	}
+	*v = RewardSourceCategory(n)
+	return nil
+}
+
+// ParseRewardSourceCategory returns the RewardSourceCategory with the given name.
+func ParseRewardSourceCategory(name string) (RewardSourceCategory, error) {
+	n, err := rewardSourceCategoryNames.parse(name, "RewardSourceCategory", false)
+	return RewardSourceCategory(n), err
+}
+
+var presentationNodeTypeNames = enumNames{
+	{0, "Default"},
+	{1, "Category"},
+	{2, "Collectibles"},
+	{3, "Records"},
+	{4, "Metric"},
+}
+
+func (v PresentationNodeType) String() string {
+	return presentationNodeTypeNames.format(int32(v), "PresentationNodeType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v PresentationNodeType) MarshalText() ([]byte, error) {
+	return presentationNodeTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *PresentationNodeType) UnmarshalText(text []byte) error {
+	n, err := presentationNodeTypeNames.parse(string(text), "PresentationNodeType", false)
+	if err != nil {
+		return err
+	}
+	*v = PresentationNodeType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *PresentationNodeType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := presentationNodeTypeNames.unmarshalJSON(data, "PresentationNodeType", false)
+	if err != nil {
+		return err
+	}
+	*v = PresentationNodeType(n)
+	return nil
+}
+
+// ParsePresentationNodeType returns the PresentationNodeType with the given name.
+func ParsePresentationNodeType(name string) (PresentationNodeType, error) {
+	n, err := presentationNodeTypeNames.parse(name, "PresentationNodeType", false)
+	return PresentationNodeType(n), err
+}
+
+var scopeNames = enumNames{
+	{0, "Profile"},
+	{1, "Character"},
+}
+
+func (v Scope) String() string {
+	return scopeNames.format(int32(v), "Scope", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Scope) MarshalText() ([]byte, error) {
+	return scopeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Scope) UnmarshalText(text []byte) error {
+	n, err := scopeNames.parse(string(text), "Scope", false)
+	if err != nil {
+		return err
+	}
+	*v = Scope(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *Scope) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := scopeNames.unmarshalJSON(data, "Scope", false)
+	if err != nil {
+		return err
+	}
+	*v = Scope(n)
+	return nil
+}
+
+// ParseScope returns the Scope with the given name.
+func ParseScope(name string) (Scope, error) {
+	n, err := scopeNames.parse(name, "Scope", false)
+	return Scope(n), err
+}
+
+var presentationDisplayStyleNames = enumNames{
+	{0, "Category"},
+	{1, "Badge"},
+	{2, "Medals"},
+	{3, "Collectible"},
+	{4, "Record"},
+}
+
+func (v PresentationDisplayStyle) String() string {
+	return presentationDisplayStyleNames.format(int32(v), "PresentationDisplayStyle", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v PresentationDisplayStyle) MarshalText() ([]byte, error) {
+	return presentationDisplayStyleNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *PresentationDisplayStyle) UnmarshalText(text []byte) error {
+	n, err := presentationDisplayStyleNames.parse(string(text), "PresentationDisplayStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = PresentationDisplayStyle(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *PresentationDisplayStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := presentationDisplayStyleNames.unmarshalJSON(data, "PresentationDisplayStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = PresentationDisplayStyle(n)
+	return nil
+}
+
+// ParsePresentationDisplayStyle returns the PresentationDisplayStyle with the given name.
+func ParsePresentationDisplayStyle(name string) (PresentationDisplayStyle, error) {
+	n, err := presentationDisplayStyleNames.parse(name, "PresentationDisplayStyle", false)
+	return PresentationDisplayStyle(n), err
+}
+
+var recordValueStyleNames = enumNames{
+	{0, "Integer"},
+	{1, "Percentage"},
+	{2, "Milliseconds"},
+	{3, "Boolean"},
+	{4, "Decimal"},
+}
+
+func (v RecordValueStyle) String() string {
+	return recordValueStyleNames.format(int32(v), "RecordValueStyle", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v RecordValueStyle) MarshalText() ([]byte, error) {
+	return recordValueStyleNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *RecordValueStyle) UnmarshalText(text []byte) error {
+	n, err := recordValueStyleNames.parse(string(text), "RecordValueStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = RecordValueStyle(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *RecordValueStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := recordValueStyleNames.unmarshalJSON(data, "RecordValueStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = RecordValueStyle(n)
+	return nil
+}
+
+// ParseRecordValueStyle returns the RecordValueStyle with the given name.
+func ParseRecordValueStyle(name string) (RecordValueStyle, error) {
+	n, err := recordValueStyleNames.parse(name, "RecordValueStyle", false)
+	return RecordValueStyle(n), err
+}
+
+var genderNames = enumNames{
+	{0, "Male"},
+	{1, "Female"},
+	{2, "Unknown"},
+}
+
+func (v Gender) String() string {
+	return genderNames.format(int32(v), "Gender", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Gender) MarshalText() ([]byte, error) {
+	return genderNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Gender) UnmarshalText(text []byte) error {
+	n, err := genderNames.parse(string(text), "Gender", false)
+	if err != nil {
+		return err
+	}
+	*v = Gender(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *Gender) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := genderNames.unmarshalJSON(data, "Gender", false)
+	if err != nil {
+		return err
+	}
+	*v = Gender(n)
+	return nil
+}
+
+// ParseGender returns the Gender with the given name.
+func ParseGender(name string) (Gender, error) {
+	n, err := genderNames.parse(name, "Gender", false)
+	return Gender(n), err
+}
+
+var recordToastStyleNames = enumNames{
+	{0, "None"},
+	{1, "Record"},
+	{2, "Lore"},
+	{3, "Badge"},
+	{4, "MetaRecord"},
+	{5, "MedalComplete"},
+	{6, "SeasonChallengeComplete"},
+	{7, "GildedTitleComplete"},
+}
+
+func (v RecordToastStyle) String() string {
+	return recordToastStyleNames.format(int32(v), "RecordToastStyle", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v RecordToastStyle) MarshalText() ([]byte, error) {
+	return recordToastStyleNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *RecordToastStyle) UnmarshalText(text []byte) error {
+	n, err := recordToastStyleNames.parse(string(text), "RecordToastStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = RecordToastStyle(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *RecordToastStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := recordToastStyleNames.unmarshalJSON(data, "RecordToastStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = RecordToastStyle(n)
+	return nil
+}
+
+// ParseRecordToastStyle returns the RecordToastStyle with the given name.
+func ParseRecordToastStyle(name string) (RecordToastStyle, error) {
+	n, err := recordToastStyleNames.parse(name, "RecordToastStyle", false)
+	return RecordToastStyle(n), err
+}
+
+var presentationScreenStyleNames = enumNames{
+	{0, "Default"},
+	{1, "CategorySets"},
+	{2, "Badge"},
+}
+
+func (v PresentationScreenStyle) String() string {
+	return presentationScreenStyleNames.format(int32(v), "PresentationScreenStyle", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v PresentationScreenStyle) MarshalText() ([]byte, error) {
+	return presentationScreenStyleNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *PresentationScreenStyle) UnmarshalText(text []byte) error {
+	n, err := presentationScreenStyleNames.parse(string(text), "PresentationScreenStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = PresentationScreenStyle(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *PresentationScreenStyle) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := presentationScreenStyleNames.unmarshalJSON(data, "PresentationScreenStyle", false)
+	if err != nil {
+		return err
+	}
+	*v = PresentationScreenStyle(n)
+	return nil
+}
+
+// ParsePresentationScreenStyle returns the PresentationScreenStyle with the given name.
+func ParsePresentationScreenStyle(name string) (PresentationScreenStyle, error) {
+	n, err := presentationScreenStyleNames.parse(name, "PresentationScreenStyle", false)
+	return PresentationScreenStyle(n), err
+}
+
+var plugUIStylesNames = enumNames{
+	{0, "None"},
+	{1, "Masterwork"},
+}
+
+func (v PlugUIStyles) String() string {
+	return plugUIStylesNames.format(int32(v), "PlugUIStyles", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v PlugUIStyles) MarshalText() ([]byte, error) {
+	return plugUIStylesNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *PlugUIStyles) UnmarshalText(text []byte) error {
+	n, err := plugUIStylesNames.parse(string(text), "PlugUIStyles", false)
+	if err != nil {
+		return err
+	}
+	*v = PlugUIStyles(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *PlugUIStyles) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := plugUIStylesNames.unmarshalJSON(data, "PlugUIStyles", false)
+	if err != nil {
+		return err
+	}
+	*v = PlugUIStyles(n)
+	return nil
+}
+
+// ParsePlugUIStyles returns the PlugUIStyles with the given name.
+func ParsePlugUIStyles(name string) (PlugUIStyles, error) {
+	n, err := plugUIStylesNames.parse(name, "PlugUIStyles", false)
+	return PlugUIStyles(n), err
+}
+
+var plugAvailabilityModeNames = enumNames{
+	{0, "Normal"},
+	{1, "UnavailableIfSocketContainsMatchingPlugCategory"},
+	{2, "AvailableIfSocketContainsMatchingPlugCategory"},
+}
+
+func (v PlugAvailabilityMode) String() string {
+	return plugAvailabilityModeNames.format(int32(v), "PlugAvailabilityMode", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v PlugAvailabilityMode) MarshalText() ([]byte, error) {
+	return plugAvailabilityModeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *PlugAvailabilityMode) UnmarshalText(text []byte) error {
+	n, err := plugAvailabilityModeNames.parse(string(text), "PlugAvailabilityMode", false)
+	if err != nil {
+		return err
+	}
+	*v = PlugAvailabilityMode(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *PlugAvailabilityMode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := plugAvailabilityModeNames.unmarshalJSON(data, "PlugAvailabilityMode", false)
+	if err != nil {
+		return err
+	}
+	*v = PlugAvailabilityMode(n)
+	return nil
+}
+
+// ParsePlugAvailabilityMode returns the PlugAvailabilityMode with the given name.
+func ParsePlugAvailabilityMode(name string) (PlugAvailabilityMode, error) {
+	n, err := plugAvailabilityModeNames.parse(name, "PlugAvailabilityMode", false)
+	return PlugAvailabilityMode(n), err
+}
+
+var energyTypeNames = enumNames{
+	{0, "Any"},
+	{1, "Arc"},
+	{2, "Thermal"},
+	{3, "Void"},
+	{4, "Ghost"},
+	{5, "Subclass"},
+	{6, "Stasis"},
+}
+
+func (v EnergyType) String() string {
+	return energyTypeNames.format(int32(v), "EnergyType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v EnergyType) MarshalText() ([]byte, error) {
+	return energyTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *EnergyType) UnmarshalText(text []byte) error {
+	n, err := energyTypeNames.parse(string(text), "EnergyType", false)
+	if err != nil {
+		return err
+	}
+	*v = EnergyType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *EnergyType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := energyTypeNames.unmarshalJSON(data, "EnergyType", false)
+	if err != nil {
+		return err
+	}
+	*v = EnergyType(n)
+	return nil
+}
+
+// ParseEnergyType returns the EnergyType with the given name.
+func ParseEnergyType(name string) (EnergyType, error) {
+	n, err := energyTypeNames.parse(name, "EnergyType", false)
+	return EnergyType(n), err
+}
+
+var socketPlugSourcesNames = enumNames{
+	{0, "None"},
+	{1, "InventorySourced"},
+	{2, "ReusablePlugItems"},
+	{4, "ProfilePlugSet"},
+	{8, "CharacterPlugSet"},
+}
+
+func (v SocketPlugSources) String() string {
+	return socketPlugSourcesNames.format(int32(v), "SocketPlugSources", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v SocketPlugSources) MarshalText() ([]byte, error) {
+	return socketPlugSourcesNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *SocketPlugSources) UnmarshalText(text []byte) error {
+	n, err := socketPlugSourcesNames.parse(string(text), "SocketPlugSources", true)
+	if err != nil {
+		return err
+	}
+	*v = SocketPlugSources(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *SocketPlugSources) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := socketPlugSourcesNames.unmarshalJSON(data, "SocketPlugSources", true)
+	if err != nil {
+		return err
+	}
+	*v = SocketPlugSources(n)
+	return nil
+}
+
+// ParseSocketPlugSources returns the SocketPlugSources with the given name.
+func ParseSocketPlugSources(name string) (SocketPlugSources, error) {
+	n, err := socketPlugSourcesNames.parse(name, "SocketPlugSources", true)
+	return SocketPlugSources(n), err
+}
+
+var itemPerkVisibilityNames = enumNames{
+	{0, "Visible"},
+	{1, "Disabled"},
+	{2, "Hidden"},
+}
+
+func (v ItemPerkVisibility) String() string {
+	return itemPerkVisibilityNames.format(int32(v), "ItemPerkVisibility", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ItemPerkVisibility) MarshalText() ([]byte, error) {
+	return itemPerkVisibilityNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ItemPerkVisibility) UnmarshalText(text []byte) error {
+	n, err := itemPerkVisibilityNames.parse(string(text), "ItemPerkVisibility", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemPerkVisibility(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ItemPerkVisibility) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := itemPerkVisibilityNames.unmarshalJSON(data, "ItemPerkVisibility", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemPerkVisibility(n)
+	return nil
+}
+
+// ParseItemPerkVisibility returns the ItemPerkVisibility with the given name.
+func ParseItemPerkVisibility(name string) (ItemPerkVisibility, error) {
+	n, err := itemPerkVisibilityNames.parse(name, "ItemPerkVisibility", false)
+	return ItemPerkVisibility(n), err
+}
+
+var specialItemTypeNames = enumNames{
+	{0, "None"},
+	{1, "SpecialCurrency"},
+	{8, "Armor"},
+	{9, "Weapon"},
+	{23, "Engram"},
+	{24, "Consumable"},
+	{25, "ExchangeMaterial"},
+	{27, "MissionReward"},
+	{29, "Currency"},
+}
+
+func (v SpecialItemType) String() string {
+	return specialItemTypeNames.format(int32(v), "SpecialItemType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v SpecialItemType) MarshalText() ([]byte, error) {
+	return specialItemTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *SpecialItemType) UnmarshalText(text []byte) error {
+	n, err := specialItemTypeNames.parse(string(text), "SpecialItemType", false)
+	if err != nil {
+		return err
+	}
+	*v = SpecialItemType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *SpecialItemType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := specialItemTypeNames.unmarshalJSON(data, "SpecialItemType", false)
+	if err != nil {
+		return err
+	}
+	*v = SpecialItemType(n)
+	return nil
+}
+
+// ParseSpecialItemType returns the SpecialItemType with the given name.
+func ParseSpecialItemType(name string) (SpecialItemType, error) {
+	n, err := specialItemTypeNames.parse(name, "SpecialItemType", false)
+	return SpecialItemType(n), err
+}
+
+var itemTypeNames = enumNames{
+	{0, "None"},
+	{1, "Currency"},
+	{2, "Armor"},
+	{3, "Weapon"},
+	{7, "Message"},
+	{8, "Engram"},
+	{9, "Consumable"},
+	{10, "ExchangeMaterial"},
+	{11, "MissionReward"},
+	{12, "QuestStep"},
+	{13, "QuestStepComplete"},
+	{14, "Emblem"},
+	{15, "Quest"},
+	{16, "Subclass"},
+	{17, "ClanBanner"},
+	{18, "Aura"},
+	{19, "Mod"},
+	{20, "Dummy"},
+	{21, "Ship"},
+	{22, "Vehicle"},
+	{23, "Emote"},
+	{24, "Ghost"},
+	{25, "Package"},
+	{26, "Bounty"},
+	{27, "Wrapper"},
+	{28, "SeasonalArtifact"},
+	{29, "Finisher"},
+}
+
+func (v ItemType) String() string {
+	return itemTypeNames.format(int32(v), "ItemType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ItemType) MarshalText() ([]byte, error) {
+	return itemTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ItemType) UnmarshalText(text []byte) error {
+	n, err := itemTypeNames.parse(string(text), "ItemType", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ItemType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := itemTypeNames.unmarshalJSON(data, "ItemType", false)
+	if err != nil {
+		return err
+	}
+	*v = ItemType(n)
+	return nil
+}
+
+// ParseItemType returns the ItemType with the given name.
+func ParseItemType(name string) (ItemType, error) {
+	n, err := itemTypeNames.parse(name, "ItemType", false)
+	return ItemType(n), err
+}
+
+var classNames = enumNames{
+	{0, "Titan"},
+	{1, "Hunter"},
+	{2, "Warlock"},
+	{3, "Unknown"},
+}
+
+func (v Class) String() string {
+	return classNames.format(int32(v), "Class", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Class) MarshalText() ([]byte, error) {
+	return classNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Class) UnmarshalText(text []byte) error {
+	n, err := classNames.parse(string(text), "Class", false)
+	if err != nil {
+		return err
+	}
+	*v = Class(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *Class) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := classNames.unmarshalJSON(data, "Class", false)
+	if err != nil {
+		return err
+	}
+	*v = Class(n)
+	return nil
+}
+
+// ParseClass returns the Class with the given name.
+func ParseClass(name string) (Class, error) {
+	n, err := classNames.parse(name, "Class", false)
+	return Class(n), err
+}
+
+var breakerTypeEnumNames = enumNames{
+	{0, "None"},
+	{1, "ShieldPiercing"},
+	{2, "Disruption"},
+	{3, "Stagger"},
+}
+
+func (v BreakerTypeEnum) String() string {
+	return breakerTypeEnumNames.format(int32(v), "BreakerTypeEnum", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v BreakerTypeEnum) MarshalText() ([]byte, error) {
+	return breakerTypeEnumNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *BreakerTypeEnum) UnmarshalText(text []byte) error {
+	n, err := breakerTypeEnumNames.parse(string(text), "BreakerTypeEnum", false)
+	if err != nil {
+		return err
+	}
+	*v = BreakerTypeEnum(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *BreakerTypeEnum) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := breakerTypeEnumNames.unmarshalJSON(data, "BreakerTypeEnum", false)
+	if err != nil {
+		return err
+	}
+	*v = BreakerTypeEnum(n)
+	return nil
+}
+
+// ParseBreakerTypeEnum returns the BreakerTypeEnum with the given name.
+func ParseBreakerTypeEnum(name string) (BreakerTypeEnum, error) {
+	n, err := breakerTypeEnumNames.parse(name, "BreakerTypeEnum", false)
+	return BreakerTypeEnum(n), err
+}
+
+var progressionRewardItemAcquisitionBehaviorNames = enumNames{
+	{0, "Instant"},
+	{1, "PlayerClaimRequired"},
+}
+
+func (v ProgressionRewardItemAcquisitionBehavior) String() string {
+	return progressionRewardItemAcquisitionBehaviorNames.format(int32(v), "ProgressionRewardItemAcquisitionBehavior", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ProgressionRewardItemAcquisitionBehavior) MarshalText() ([]byte, error) {
+	return progressionRewardItemAcquisitionBehaviorNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ProgressionRewardItemAcquisitionBehavior) UnmarshalText(text []byte) error {
+	n, err := progressionRewardItemAcquisitionBehaviorNames.parse(string(text), "ProgressionRewardItemAcquisitionBehavior", false)
+	if err != nil {
+		return err
+	}
+	*v = ProgressionRewardItemAcquisitionBehavior(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ProgressionRewardItemAcquisitionBehavior) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := progressionRewardItemAcquisitionBehaviorNames.unmarshalJSON(data, "ProgressionRewardItemAcquisitionBehavior", false)
+	if err != nil {
+		return err
+	}
+	*v = ProgressionRewardItemAcquisitionBehavior(n)
+	return nil
+}
+
+// ParseProgressionRewardItemAcquisitionBehavior returns the ProgressionRewardItemAcquisitionBehavior with the given name.
+func ParseProgressionRewardItemAcquisitionBehavior(name string) (ProgressionRewardItemAcquisitionBehavior, error) {
+	n, err := progressionRewardItemAcquisitionBehaviorNames.parse(name, "ProgressionRewardItemAcquisitionBehavior", false)
+	return ProgressionRewardItemAcquisitionBehavior(n), err
+}
+
+var presentationNodeStateNames = enumNames{
+	{0, "None"},
+	{1, "Invisible"},
+	{2, "Obscured"},
+}
+
+func (v PresentationNodeState) String() string {
+	return presentationNodeStateNames.format(int32(v), "PresentationNodeState", true)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v PresentationNodeState) MarshalText() ([]byte, error) {
+	return presentationNodeStateNames.marshal(int32(v), true), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *PresentationNodeState) UnmarshalText(text []byte) error {
+	n, err := presentationNodeStateNames.parse(string(text), "PresentationNodeState", true)
+	if err != nil {
+		return err
+	}
+	*v = PresentationNodeState(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *PresentationNodeState) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := presentationNodeStateNames.unmarshalJSON(data, "PresentationNodeState", true)
+	if err != nil {
+		return err
+	}
+	*v = PresentationNodeState(n)
+	return nil
+}
+
+// ParsePresentationNodeState returns the PresentationNodeState with the given name.
+func ParsePresentationNodeState(name string) (PresentationNodeState, error) {
+	n, err := presentationNodeStateNames.parse(name, "PresentationNodeState", true)
+	return PresentationNodeState(n), err
+}
+
+var raceNames = enumNames{
+	{0, "Human"},
+	{1, "Awoken"},
+	{2, "Exo"},
+	{3, "Unknown"},
+}
+
+func (v Race) String() string {
+	return raceNames.format(int32(v), "Race", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Race) MarshalText() ([]byte, error) {
+	return raceNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Race) UnmarshalText(text []byte) error {
+	n, err := raceNames.parse(string(text), "Race", false)
+	if err != nil {
+		return err
+	}
+	*v = Race(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *Race) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := raceNames.unmarshalJSON(data, "Race", false)
+	if err != nil {
+		return err
+	}
+	*v = Race(n)
+	return nil
+}
+
+// ParseRace returns the Race with the given name.
+func ParseRace(name string) (Race, error) {
+	n, err := raceNames.parse(name, "Race", false)
+	return Race(n), err
+}
+
+var milestoneTypeNames = enumNames{
+	{0, "Unknown"},
+	{1, "Tutorial"},
+	{2, "OneTime"},
+	{3, "Weekly"},
+	{4, "Daily"},
+	{5, "Special"},
+}
+
+func (v MilestoneType) String() string {
+	return milestoneTypeNames.format(int32(v), "MilestoneType", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v MilestoneType) MarshalText() ([]byte, error) {
+	return milestoneTypeNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *MilestoneType) UnmarshalText(text []byte) error {
+	n, err := milestoneTypeNames.parse(string(text), "MilestoneType", false)
+	if err != nil {
+		return err
+	}
+	*v = MilestoneType(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *MilestoneType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := milestoneTypeNames.unmarshalJSON(data, "MilestoneType", false)
+	if err != nil {
+		return err
+	}
+	*v = MilestoneType(n)
+	return nil
+}
+
+// ParseMilestoneType returns the MilestoneType with the given name.
+func ParseMilestoneType(name string) (MilestoneType, error) {
+	n, err := milestoneTypeNames.parse(name, "MilestoneType", false)
+	return MilestoneType(n), err
+}
+
+var milestoneDisplayPreferenceNames = enumNames{
+	{0, "MilestoneDefinition"},
+	{1, "CurrentQuestSteps"},
+	{2, "CurrentActivityChallenges"},
+}
+
+func (v MilestoneDisplayPreference) String() string {
+	return milestoneDisplayPreferenceNames.format(int32(v), "MilestoneDisplayPreference", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v MilestoneDisplayPreference) MarshalText() ([]byte, error) {
+	return milestoneDisplayPreferenceNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *MilestoneDisplayPreference) UnmarshalText(text []byte) error {
+	n, err := milestoneDisplayPreferenceNames.parse(string(text), "MilestoneDisplayPreference", false)
+	if err != nil {
+		return err
+	}
+	*v = MilestoneDisplayPreference(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *MilestoneDisplayPreference) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := milestoneDisplayPreferenceNames.unmarshalJSON(data, "MilestoneDisplayPreference", false)
+	if err != nil {
+		return err
+	}
+	*v = MilestoneDisplayPreference(n)
+	return nil
+}
+
+// ParseMilestoneDisplayPreference returns the MilestoneDisplayPreference with the given name.
+func ParseMilestoneDisplayPreference(name string) (MilestoneDisplayPreference, error) {
+	n, err := milestoneDisplayPreferenceNames.parse(name, "MilestoneDisplayPreference", false)
+	return MilestoneDisplayPreference(n), err
+}
+
+var activityDifficultyTierNames = enumNames{
+	{0, "Trivial"},
+	{1, "Easy"},
+	{2, "Normal"},
+	{3, "Challenging"},
+	{4, "Hard"},
+	{5, "Brave"},
+	{6, "AlmostImpossible"},
+	{7, "Impossible"},
+}
+
+func (v ActivityDifficultyTier) String() string {
+	return activityDifficultyTierNames.format(int32(v), "ActivityDifficultyTier", false)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ActivityDifficultyTier) MarshalText() ([]byte, error) {
+	return activityDifficultyTierNames.marshal(int32(v), false), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *ActivityDifficultyTier) UnmarshalText(text []byte) error {
+	n, err := activityDifficultyTierNames.parse(string(text), "ActivityDifficultyTier", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityDifficultyTier(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *ActivityDifficultyTier) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := activityDifficultyTierNames.unmarshalJSON(data, "ActivityDifficultyTier", false)
+	if err != nil {
+		return err
+	}
+	*v = ActivityDifficultyTier(n)
+	return nil
+}
+
+// ParseActivityDifficultyTier returns the ActivityDifficultyTier with the given name.
+func ParseActivityDifficultyTier(name string) (ActivityDifficultyTier, error) {
+	n, err := activityDifficultyTierNames.parse(name, "ActivityDifficultyTier", false)
+	return ActivityDifficultyTier(n), err
+}
@@ -0,0 +1,88 @@
+package destiny2
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestEnumString(t *testing.T) {
+	tests := []struct {
+		value fmt.Stringer
+		want  string
+	}{
+		{ItemType(Item_Weapon), "Weapon"},
+		{DamageType(DamageType_Void), "Void"},
+		{ActivityModeType(ActivityMode_TrialsOfOsiris), "TrialsOfOsiris"},
+		{BucketCategory(BucketCategory_Equippable), "Equippable"},
+		{Class(Class_Warlock), "Warlock"},
+		{ItemType(99), "ItemType(99)"},
+		{SocketPlugSources(SocketPlug_None), "None"},
+		{SocketPlugSources(SocketPlug_InventorySourced | SocketPlug_ReusablePlugItems), "InventorySourced|ReusablePlugItems"},
+		{EquippingItemBlockAttributes(EquippingAttribute_EquipOnAcquire), "EquipOnAcquire"},
+		{TalentNodeStepDamageTypes(TalentNodeStepDamageType_All), "All"},
+		{TalentNodeStepDamageTypes(TalentNodeStepDamageType_Arc | 32), "Arc|TalentNodeStepDamageTypes(32)"},
+	}
+
+	for _, test := range tests {
+		if got := test.value.String(); got != test.want {
+			t.Errorf("String(): got %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestEnumText(t *testing.T) {
+	for _, name := range []string{"ScoutRifle", "scoutrifle", "14"} {
+		got, err := ParseItemSubType(name)
+		if err != nil {
+			t.Errorf("ParseItemSubType(%q): %v", name, err)
+			continue
+		}
+		if got != SubType_ScoutRifle {
+			t.Errorf("ParseItemSubType(%q): got %v, want ScoutRifle", name, got)
+		}
+	}
+
+	if _, err := ParseItemSubType("Spoon"); err == nil {
+		t.Error(`ParseItemSubType("Spoon") should fail`)
+	}
+
+	sources, err := ParseSocketPlugSources("ReusablePlugItems|CharacterPlugSet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := SocketPlugSources(SocketPlug_ReusablePlugItems | SocketPlug_CharacterPlugSet); sources != want {
+		t.Errorf("ParseSocketPlugSources: got %d, want %d", sources, want)
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	type block struct {
+		Class   Class
+		Sources SocketPlugSources
+		Damage  []DamageType
+	}
+
+	// Bungie.net uses numeric values, which should still be accepted.
+	var fromBungie block
+	if err := json.Unmarshal([]byte(`{"Class": 1, "Sources": 6, "Damage": [2, 6]}`), &fromBungie); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(fromBungie)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"Class":"Hunter","Sources":"ReusablePlugItems|ProfilePlugSet","Damage":["Arc","Stasis"]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal: got %s, want %s", data, want)
+	}
+
+	var fromNames block
+	if err := json.Unmarshal(data, &fromNames); err != nil {
+		t.Fatal(err)
+	}
+	if fromNames.Class != fromBungie.Class || fromNames.Sources != fromBungie.Sources || len(fromNames.Damage) != 2 {
+		t.Errorf("json round trip: got %+v, want %+v", fromNames, fromBungie)
+	}
+}
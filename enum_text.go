@@ -0,0 +1,130 @@
+package destiny2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run gen_enum.go
+
+// EnumError represents an error parsing the name of an enum value.
+type EnumError struct {
+	enum, name string
+}
+
+func (e EnumError) Error() string {
+	return fmt.Sprintf("%q is not a valid %s", e.name, e.enum)
+}
+
+// enumName is the name of a single enum value.
+type enumName struct {
+	value int32
+	name  string
+}
+
+// enumNames are all named values of an enum, in the order they are declared in enum.go.
+// The first name declared for a value is used when formatting it.
+type enumNames []enumName
+
+func (names enumNames) lookup(v int32) (string, bool) {
+	for _, n := range names {
+		if n.value == v {
+			return n.name, true
+		}
+	}
+	return "", false
+}
+
+// flagNames returns the names of each single-bit flag set in v and any remaining unnamed bits.
+func (names enumNames) flagNames(v int32) ([]string, int32) {
+	var set []string
+	remaining := v
+	for _, n := range names {
+		if n.value == 0 || n.value&(n.value-1) != 0 {
+			// Skip the empty value and combinations such as All.
+			continue
+		}
+		if v&n.value == n.value && remaining&n.value != 0 {
+			set = append(set, n.name)
+			remaining &^= n.value
+		}
+	}
+	return set, remaining
+}
+
+func (names enumNames) format(v int32, enum string, flags bool) string {
+	if name, ok := names.lookup(v); ok {
+		return name
+	}
+	if !flags {
+		return fmt.Sprintf("%s(%d)", enum, v)
+	}
+
+	set, remaining := names.flagNames(v)
+	if remaining != 0 {
+		set = append(set, fmt.Sprintf("%s(%d)", enum, remaining))
+	}
+	return strings.Join(set, "|")
+}
+
+func (names enumNames) marshal(v int32, flags bool) []byte {
+	if name, ok := names.lookup(v); ok {
+		return []byte(name)
+	}
+	if !flags {
+		return []byte(strconv.Itoa(int(v)))
+	}
+
+	set, remaining := names.flagNames(v)
+	if remaining != 0 {
+		set = append(set, strconv.Itoa(int(remaining)))
+	}
+	return []byte(strings.Join(set, "|"))
+}
+
+// parse parses a name or a decimal value. Flags may be combined with "|".
+func (names enumNames) parse(text, enum string, flags bool) (int32, error) {
+	if !flags {
+		return names.parseOne(strings.TrimSpace(text), enum)
+	}
+
+	var v int32
+	for _, part := range strings.Split(text, "|") {
+		n, err := names.parseOne(strings.TrimSpace(part), enum)
+		if err != nil {
+			return 0, err
+		}
+		v |= n
+	}
+	return v, nil
+}
+
+func (names enumNames) parseOne(text, enum string) (int32, error) {
+	if n, err := strconv.ParseInt(text, 10, 32); err == nil {
+		return int32(n), nil
+	}
+	for _, n := range names {
+		if strings.EqualFold(n.name, text) {
+			return n.value, nil
+		}
+	}
+	return 0, EnumError{enum, text}
+}
+
+func (names enumNames) unmarshalJSON(data []byte, enum string, flags bool) (int32, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return 0, err
+		}
+		return names.parse(text, enum, flags)
+	}
+
+	var n int32
+	if err := json.Unmarshal(data, &n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
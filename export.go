@@ -0,0 +1,368 @@
+package destiny2
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// sqlSchema is the normalized schema written by ExportSQL.
+// Enum columns store the name of the value, such as "ScoutRifle", rather than its number.
+// Foreign keys are declared for documentation and joins; they are not enforced so that contracts can be exported independently.
+const sqlSchema = `
+CREATE TABLE IF NOT EXISTS items (
+	hash INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	icon TEXT NOT NULL,
+	flavor_text TEXT NOT NULL,
+	item_type_display_name TEXT NOT NULL,
+	item_type TEXT NOT NULL,
+	item_sub_type TEXT NOT NULL,
+	class_type TEXT NOT NULL,
+	tier_type TEXT NOT NULL,
+	tier_type_name TEXT NOT NULL,
+	bucket_type_hash INTEGER NOT NULL,
+	default_damage_type TEXT NOT NULL,
+	collectible_hash INTEGER NOT NULL,
+	season_hash INTEGER NOT NULL,
+	plug_category_hash INTEGER NOT NULL,
+	plug_category_identifier TEXT NOT NULL,
+	equippable INTEGER NOT NULL,
+	redacted INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS items_name ON items(name);
+CREATE INDEX IF NOT EXISTS items_type ON items(item_type, item_sub_type, tier_type);
+
+CREATE TABLE IF NOT EXISTS item_categories (
+	item_hash INTEGER NOT NULL REFERENCES items(hash),
+	item_category_hash INTEGER NOT NULL,
+	PRIMARY KEY (item_hash, item_category_hash)
+);
+CREATE INDEX IF NOT EXISTS item_categories_category ON item_categories(item_category_hash);
+
+CREATE TABLE IF NOT EXISTS stats (
+	hash INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	icon TEXT NOT NULL,
+	aggregation_type TEXT NOT NULL,
+	stat_category TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS item_stats (
+	item_hash INTEGER NOT NULL REFERENCES items(hash),
+	stat_hash INTEGER NOT NULL REFERENCES stats(hash),
+	value INTEGER NOT NULL,
+	minimum INTEGER NOT NULL,
+	maximum INTEGER NOT NULL,
+	display_maximum INTEGER NOT NULL,
+	PRIMARY KEY (item_hash, stat_hash)
+);
+CREATE INDEX IF NOT EXISTS item_stats_stat ON item_stats(stat_hash);
+
+CREATE TABLE IF NOT EXISTS item_investment_stats (
+	item_hash INTEGER NOT NULL REFERENCES items(hash),
+	stat_hash INTEGER NOT NULL REFERENCES stats(hash),
+	value INTEGER NOT NULL,
+	is_conditionally_active INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS item_investment_stats_item ON item_investment_stats(item_hash);
+CREATE INDEX IF NOT EXISTS item_investment_stats_stat ON item_investment_stats(stat_hash);
+
+CREATE TABLE IF NOT EXISTS item_sockets (
+	item_hash INTEGER NOT NULL REFERENCES items(hash),
+	socket_index INTEGER NOT NULL,
+	socket_type_hash INTEGER NOT NULL,
+	single_initial_item_hash INTEGER NOT NULL,
+	reusable_plug_set_hash INTEGER NOT NULL REFERENCES plug_sets(hash),
+	randomized_plug_set_hash INTEGER NOT NULL REFERENCES plug_sets(hash),
+	plug_sources TEXT NOT NULL,
+	default_visible INTEGER NOT NULL,
+	PRIMARY KEY (item_hash, socket_index)
+);
+CREATE INDEX IF NOT EXISTS item_sockets_reusable ON item_sockets(reusable_plug_set_hash);
+CREATE INDEX IF NOT EXISTS item_sockets_randomized ON item_sockets(randomized_plug_set_hash);
+
+CREATE TABLE IF NOT EXISTS item_socket_plugs (
+	item_hash INTEGER NOT NULL REFERENCES items(hash),
+	socket_index INTEGER NOT NULL,
+	plug_item_hash INTEGER NOT NULL REFERENCES items(hash),
+	PRIMARY KEY (item_hash, socket_index, plug_item_hash)
+);
+CREATE INDEX IF NOT EXISTS item_socket_plugs_plug ON item_socket_plugs(plug_item_hash);
+
+CREATE TABLE IF NOT EXISTS item_perks (
+	item_hash INTEGER NOT NULL REFERENCES items(hash),
+	perk_hash INTEGER NOT NULL REFERENCES perks(hash),
+	requirement_display_string TEXT NOT NULL,
+	perk_visibility TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS item_perks_item ON item_perks(item_hash);
+CREATE INDEX IF NOT EXISTS item_perks_perk ON item_perks(perk_hash);
+
+CREATE TABLE IF NOT EXISTS plug_sets (
+	hash INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	is_fake_plug_set INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS plug_set_items (
+	plug_set_hash INTEGER NOT NULL REFERENCES plug_sets(hash),
+	plug_item_hash INTEGER NOT NULL REFERENCES items(hash),
+	currently_can_roll INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS plug_set_items_set ON plug_set_items(plug_set_hash);
+CREATE INDEX IF NOT EXISTS plug_set_items_plug ON plug_set_items(plug_item_hash);
+
+CREATE TABLE IF NOT EXISTS perks (
+	hash INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	icon TEXT NOT NULL,
+	is_displayable INTEGER NOT NULL,
+	damage_type TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS perks_name ON perks(name);
+
+CREATE TABLE IF NOT EXISTS activities (
+	hash INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	icon TEXT NOT NULL,
+	activity_light_level INTEGER NOT NULL,
+	destination_hash INTEGER NOT NULL,
+	place_hash INTEGER NOT NULL,
+	activity_type_hash INTEGER NOT NULL,
+	tier TEXT NOT NULL,
+	direct_activity_mode_type TEXT NOT NULL,
+	is_playlist INTEGER NOT NULL,
+	is_pvp INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS activities_name ON activities(name);
+
+CREATE TABLE IF NOT EXISTS activity_modes (
+	activity_hash INTEGER NOT NULL REFERENCES activities(hash),
+	activity_mode_type TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS activity_modes_activity ON activity_modes(activity_hash);
+
+CREATE TABLE IF NOT EXISTS records (
+	hash INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	icon TEXT NOT NULL,
+	scope TEXT NOT NULL,
+	lore_hash INTEGER NOT NULL,
+	record_value_style TEXT NOT NULL,
+	for_title_gilding INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS records_name ON records(name);
+
+CREATE TABLE IF NOT EXISTS record_objectives (
+	record_hash INTEGER NOT NULL REFERENCES records(hash),
+	objective_hash INTEGER NOT NULL,
+	PRIMARY KEY (record_hash, objective_hash)
+);
+`
+
+// ExportSQLite writes fulfilled contracts into a normalized SQLite database at path.
+// See ExportSQL for the supported contracts.
+func ExportSQLite(path string, contracts ...Contract) error {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return ExportSQL(db, contracts...)
+}
+
+// ExportSQL writes fulfilled contracts into a normalized relational schema in db, replacing any existing rows.
+// The supported contracts are InventoryItemDefinition, StatDefinition, PlugSetDefinition,
+// SandboxPerkDefinition, ActivityDefinition and RecordDefinition.
+func ExportSQL(db *sql.DB, contracts ...Contract) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(sqlSchema); err != nil {
+		return err
+	}
+
+	for _, contract := range contracts {
+		var err error
+		switch c := contract.(type) {
+		case *InventoryItemDefinition:
+			err = exportItems(tx, *c)
+		case *StatDefinition:
+			err = exportStats(tx, *c)
+		case *PlugSetDefinition:
+			err = exportPlugSets(tx, *c)
+		case *SandboxPerkDefinition:
+			err = exportPerks(tx, *c)
+		case *ActivityDefinition:
+			err = exportActivities(tx, *c)
+		case *RecordDefinition:
+			err = exportRecords(tx, *c)
+		default:
+			err = fmt.Errorf("%q cannot be exported", contract.Name())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// sqlExporter prepares statements for a set of tables and stops at the first error.
+type sqlExporter struct {
+	stmts map[string]*sql.Stmt
+	err   error
+}
+
+// newSQLExporter deletes all existing rows from tables and prepares an insert statement for each table.
+func newSQLExporter(tx *sql.Tx, tables []string, inserts map[string]string) *sqlExporter {
+	e := &sqlExporter{stmts: map[string]*sql.Stmt{}}
+	for _, table := range tables {
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s", table)); err != nil {
+			e.err = err
+			return e
+		}
+	}
+	for table, query := range inserts {
+		stmt, err := tx.Prepare(query)
+		if err != nil {
+			e.err = err
+			return e
+		}
+		e.stmts[table] = stmt
+	}
+	return e
+}
+
+func (e *sqlExporter) insert(table string, args ...interface{}) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.stmts[table].Exec(args...)
+}
+
+func (e *sqlExporter) close() error {
+	for _, stmt := range e.stmts {
+		stmt.Close()
+	}
+	return e.err
+}
+
+func exportItems(tx *sql.Tx, items InventoryItemDefinition) error {
+	tables := []string{"items", "item_categories", "item_stats", "item_investment_stats", "item_sockets", "item_socket_plugs", "item_perks"}
+	e := newSQLExporter(tx, tables, map[string]string{
+		"items":                 "INSERT INTO items VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		"item_categories":       "INSERT OR IGNORE INTO item_categories VALUES (?, ?)",
+		"item_stats":            "INSERT OR REPLACE INTO item_stats VALUES (?, ?, ?, ?, ?, ?)",
+		"item_investment_stats": "INSERT INTO item_investment_stats VALUES (?, ?, ?, ?)",
+		"item_sockets":          "INSERT INTO item_sockets VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		"item_socket_plugs":     "INSERT OR IGNORE INTO item_socket_plugs VALUES (?, ?, ?)",
+		"item_perks":            "INSERT INTO item_perks VALUES (?, ?, ?, ?)",
+	})
+
+	for hash, item := range items {
+		props := item.DisplayProperties
+		e.insert("items", hash, props.Name, props.Description, props.Icon, item.FlavorText, item.ItemTypeDisplayName,
+			item.ItemType.String(), item.ItemSubType.String(), item.ClassType.String(),
+			item.Inventory.TierType.String(), item.Inventory.TierTypeName, item.Inventory.BucketTypeHash,
+			item.DefaultDamageType.String(), item.CollectibleHash, item.SeasonHash,
+			item.Plug.PlugCategoryHash, item.Plug.PlugCategoryIdentifier, item.Equippable, item.Redacted)
+
+		for _, category := range item.ItemCategoryHashes {
+			e.insert("item_categories", hash, category)
+		}
+		for statHash, stat := range item.Stats.Stats {
+			e.insert("item_stats", hash, statHash, stat.Value, stat.Minimum, stat.Maximum, stat.DisplayMaximum)
+		}
+		for _, stat := range item.InvestmentStats {
+			e.insert("item_investment_stats", hash, stat.StatTypeHash, stat.Value, stat.IsConditionallyActive)
+		}
+		for i, socket := range item.Sockets.SocketEntries {
+			e.insert("item_sockets", hash, i, socket.SocketTypeHash, socket.SingleInitialItemHash,
+				socket.ReusablePlugSetHash, socket.RandomizedPlugSetHash, socket.PlugSources.String(), socket.DefaultVisible)
+			for _, plug := range socket.ReusablePlugItems {
+				e.insert("item_socket_plugs", hash, i, plug.PlugItemHash)
+			}
+		}
+		for _, perk := range item.Perks {
+			e.insert("item_perks", hash, perk.PerkHash, perk.RequirementDisplayString, perk.PerkVisibility.String())
+		}
+	}
+	return e.close()
+}
+
+func exportStats(tx *sql.Tx, stats StatDefinition) error {
+	e := newSQLExporter(tx, []string{"stats"}, map[string]string{
+		"stats": "INSERT INTO stats VALUES (?, ?, ?, ?, ?, ?)",
+	})
+	for hash, stat := range stats {
+		props := stat.DisplayProperties
+		e.insert("stats", hash, props.Name, props.Description, props.Icon, stat.AggregationType.String(), stat.StatCategory.String())
+	}
+	return e.close()
+}
+
+func exportPlugSets(tx *sql.Tx, plugSets PlugSetDefinition) error {
+	e := newSQLExporter(tx, []string{"plug_sets", "plug_set_items"}, map[string]string{
+		"plug_sets":      "INSERT INTO plug_sets VALUES (?, ?, ?)",
+		"plug_set_items": "INSERT INTO plug_set_items VALUES (?, ?, ?)",
+	})
+	for hash, plugSet := range plugSets {
+		e.insert("plug_sets", hash, plugSet.DisplayProperties.Name, plugSet.IsFakePlugSet)
+		for _, plug := range plugSet.ReusablePlugItems {
+			e.insert("plug_set_items", hash, plug.PlugItemHash, plug.CurrentlyCanRoll)
+		}
+	}
+	return e.close()
+}
+
+func exportPerks(tx *sql.Tx, perks SandboxPerkDefinition) error {
+	e := newSQLExporter(tx, []string{"perks"}, map[string]string{
+		"perks": "INSERT INTO perks VALUES (?, ?, ?, ?, ?, ?)",
+	})
+	for hash, perk := range perks {
+		props := perk.DisplayProperties
+		e.insert("perks", hash, props.Name, props.Description, props.Icon, perk.IsDisplayable, perk.DamageType.String())
+	}
+	return e.close()
+}
+
+func exportActivities(tx *sql.Tx, activities ActivityDefinition) error {
+	e := newSQLExporter(tx, []string{"activities", "activity_modes"}, map[string]string{
+		"activities":     "INSERT INTO activities VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		"activity_modes": "INSERT INTO activity_modes VALUES (?, ?)",
+	})
+	for hash, activity := range activities {
+		props := activity.DisplayProperties
+		e.insert("activities", hash, props.Name, props.Description, props.Icon, activity.ActivityLightLevel,
+			activity.DestinationHash, activity.PlaceHash, activity.ActivityTypeHash, activity.Tier.String(),
+			activity.DirectActivityModeType.String(), activity.IsPlaylist, activity.IsPvP)
+		for _, mode := range activity.ActivityModeTypes {
+			e.insert("activity_modes", hash, mode.String())
+		}
+	}
+	return e.close()
+}
+
+func exportRecords(tx *sql.Tx, records RecordDefinition) error {
+	e := newSQLExporter(tx, []string{"records", "record_objectives"}, map[string]string{
+		"records":           "INSERT INTO records VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		"record_objectives": "INSERT OR IGNORE INTO record_objectives VALUES (?, ?)",
+	})
+	for hash, record := range records {
+		props := record.DisplayProperties
+		e.insert("records", hash, props.Name, props.Description, props.Icon, record.Scope.String(),
+			record.LoreHash, record.RecordValueStyle.String(), record.ForTitleGilding)
+		for _, objective := range record.ObjectiveHashes {
+			e.insert("record_objectives", hash, objective)
+		}
+	}
+	return e.close()
+}
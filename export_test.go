@@ -0,0 +1,102 @@
+package destiny2
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExportSQLite(t *testing.T) {
+	const (
+		rampage   = 3425386926
+		outlaw    = 1168162263
+		perksSet  = 1000
+		scoutHash = 1
+	)
+
+	items := InventoryItemDefinition{
+		scoutHash: {
+			DisplayProperties: DisplayProperties{Name: "Legendary Scout"},
+			ItemType:          Item_Weapon,
+			ItemSubType:       SubType_ScoutRifle,
+			Inventory:         ItemInventoryBlock{TierType: ItemTier_Superior},
+			Sockets: ItemSocketBlock{SocketEntries: []ItemSocketEntry{
+				{RandomizedPlugSetHash: perksSet},
+			}},
+			EntityMetadata: EntityMetadata{Hash: scoutHash},
+		},
+		2: {
+			DisplayProperties: DisplayProperties{Name: "Rare Scout"},
+			ItemType:          Item_Weapon,
+			ItemSubType:       SubType_ScoutRifle,
+			Inventory:         ItemInventoryBlock{TierType: ItemTier_Rare},
+			Sockets: ItemSocketBlock{SocketEntries: []ItemSocketEntry{
+				{RandomizedPlugSetHash: perksSet},
+			}},
+			EntityMetadata: EntityMetadata{Hash: 2},
+		},
+		rampage: {DisplayProperties: DisplayProperties{Name: "Rampage"}, EntityMetadata: EntityMetadata{Hash: rampage}},
+		outlaw:  {DisplayProperties: DisplayProperties{Name: "Outlaw"}, EntityMetadata: EntityMetadata{Hash: outlaw}},
+	}
+	plugSets := PlugSetDefinition{
+		perksSet: {
+			ReusablePlugItems: []ItemSocketEntryPlugItemRandomized{
+				{PlugItemHash: rampage, CurrentlyCanRoll: true},
+				{PlugItemHash: outlaw, CurrentlyCanRoll: true},
+			},
+			EntityMetadata: EntityMetadata{Hash: perksSet},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "manifest.sqlite")
+	if err := ExportSQLite(path, &items, &plugSets); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT DISTINCT i.name FROM items i
+		JOIN item_sockets s ON s.item_hash = i.hash
+		JOIN plug_set_items p ON p.plug_set_hash = s.randomized_plug_set_hash
+		JOIN items perk ON perk.hash = p.plug_item_hash
+		WHERE i.item_sub_type = 'ScoutRifle' AND i.tier_type = 'Superior' AND perk.name = 'Rampage'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, name)
+	}
+	if diff := cmp.Diff([]string{"Legendary Scout"}, got); diff != "" {
+		t.Errorf("Legendary scouts that can roll Rampage differ: %s", diff)
+	}
+
+	// Exporting again replaces the existing rows.
+	if err := ExportSQLite(path, &items); err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM items").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != len(items) {
+		t.Errorf("items after re-export: got %d rows, want %d", count, len(items))
+	}
+
+	if err := ExportSQLite(path, &GenderDefinition{}); err == nil {
+		t.Error("Exporting an unsupported contract should fail")
+	}
+}
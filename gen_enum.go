@@ -0,0 +1,173 @@
+//go:build ignore
+// +build ignore
+
+// gen_enum generates enum_string.go, the String, text and JSON methods for every integer enum in enum.go.
+// Enums whose documentation mentions a bitmask are rendered as lists of flags.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type enumValue struct {
+	name  string
+	value int64
+}
+
+type enum struct {
+	name   string
+	flags  bool
+	values []enumValue
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "enum.go", nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var enums []*enum
+	byName := map[string]*enum{}
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		switch gen.Tok {
+		case token.TYPE:
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if ident, ok := typeSpec.Type.(*ast.Ident); !ok || ident.Name != "int32" {
+					continue
+				}
+				e := &enum{
+					name:  typeSpec.Name.Name,
+					flags: strings.Contains(gen.Doc.Text(), "bitmask"),
+				}
+				enums = append(enums, e)
+				byName[e.name] = e
+			}
+		case token.CONST:
+			// Only the first constant in each block is typed, the rest take the type of the block.
+			var current *enum
+			for _, spec := range gen.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				if ident, ok := valueSpec.Type.(*ast.Ident); ok {
+					current = byName[ident.Name]
+				}
+				if current == nil {
+					continue
+				}
+				for i, name := range valueSpec.Names {
+					current.values = append(current.values, enumValue{
+						name:  displayName(name.Name),
+						value: constValue(valueSpec.Values[i]),
+					})
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_enum.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package destiny2")
+	for _, e := range enums {
+		writeEnum(&buf, e)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("enum_string.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// displayName strips the type prefix from a constant, such as "ItemTier_Exotic" to "Exotic".
+func displayName(name string) string {
+	if i := strings.Index(name, "_"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+func constValue(expr ast.Expr) int64 {
+	sign := int64(1)
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.SUB {
+		sign = -1
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		log.Fatalf("unsupported constant value %#v", expr)
+	}
+	v, err := strconv.ParseInt(lit.Value, 0, 64)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return sign * v
+}
+
+func writeEnum(buf *bytes.Buffer, e *enum) {
+	names := string(unicode.ToLower(rune(e.name[0]))) + e.name[1:] + "Names"
+
+	fmt.Fprintf(buf, "\nvar %s = enumNames{\n", names)
+	for _, v := range e.values {
+		fmt.Fprintf(buf, "\t{%d, %q},\n", v.value, v.name)
+	}
+	fmt.Fprintln(buf, "}")
+
+	fmt.Fprintf(buf, `
+func (v %[1]s) String() string {
+	return %[2]s.format(int32(v), %[1]q, %[3]t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v %[1]s) MarshalText() ([]byte, error) {
+	return %[2]s.marshal(int32(v), %[3]t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *%[1]s) UnmarshalText(text []byte) error {
+	n, err := %[2]s.parse(string(text), %[1]q, %[3]t)
+	if err != nil {
+		return err
+	}
+	*v = %[1]s(n)
+	return nil
+}
+
+// UnmarshalJSON accepts either the numeric value used by the Bungie.net API or a name.
+func (v *%[1]s) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	n, err := %[2]s.unmarshalJSON(data, %[1]q, %[3]t)
+	if err != nil {
+		return err
+	}
+	*v = %[1]s(n)
+	return nil
+}
+
+// Parse%[1]s returns the %[1]s with the given name.
+func Parse%[1]s(name string) (%[1]s, error) {
+	n, err := %[2]s.parse(name, %[1]q, %[3]t)
+	return %[1]s(n), err
+}
+`, e.name, names, e.flags)
+}
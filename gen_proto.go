@@ -0,0 +1,433 @@
+//go:build ignore
+// +build ignore
+
+// gen_proto generates service/protos/manifest/manifest.proto, a protobuf message for every entity in entity.go
+// and the types they contain, and proto_convert.go, which converts between the entities and their messages.
+// Embedded structs are flattened into the messages that contain them and enums are represented by their values.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+	"unicode"
+)
+
+// sources are the files declaring the entities and every type they contain.
+var sources = []string{"entity.go", "definition.go", "common.go", "misc.go", "enum.go", "constant.go"}
+
+const (
+	protoFile   = "service/protos/manifest/manifest.proto"
+	convertFile = "proto_convert.go"
+	pbPackage   = "manifestpb"
+)
+
+// scalars maps Go basic types to their protobuf type and the Go type protoc-gen-go uses for it.
+var scalars = map[string]struct{ proto, goType string }{
+	"bool":    {"bool", "bool"},
+	"string":  {"string", "string"},
+	"int8":    {"int32", "int32"},
+	"int16":   {"int32", "int32"},
+	"int32":   {"int32", "int32"},
+	"int":     {"int64", "int64"},
+	"int64":   {"int64", "int64"},
+	"uint8":   {"uint32", "uint32"},
+	"byte":    {"uint32", "uint32"},
+	"uint16":  {"uint32", "uint32"},
+	"uint32":  {"uint32", "uint32"},
+	"uint":    {"uint64", "uint64"},
+	"uint64":  {"uint64", "uint64"},
+	"float32": {"float", "float32"},
+	"float64": {"double", "float64"},
+}
+
+// typeKind is how a Go type is represented in protobuf.
+type typeKind int
+
+const (
+	kindScalar typeKind = iota
+	kindMessage
+	kindTimestamp
+	kindRepeated
+	kindMap
+)
+
+// fieldType is a resolved Go type.
+type fieldType struct {
+	kind typeKind
+	// goType is the Go type of a scalar, message or timestamp.
+	goType string
+	// proto and pbType are the protobuf type and the Go type protoc-gen-go uses for it.
+	proto, pbType string
+	// elem is the element of a repeated field or the value of a map, and key is the key of a map.
+	elem, key *fieldType
+}
+
+type field struct {
+	goName, protoName, pbName string
+	doc                       string
+	typ                       *fieldType
+}
+
+type message struct {
+	name   string
+	doc    string
+	root   bool
+	fields []field
+}
+
+type generator struct {
+	types    map[string]*ast.TypeSpec
+	docs     map[string]string
+	messages []*message
+	byName   map[string]*message
+	usesTime bool
+}
+
+func main() {
+	g := &generator{types: map[string]*ast.TypeSpec{}, docs: map[string]string{}, byName: map[string]*message{}}
+	fset := token.NewFileSet()
+	var roots []string
+	for _, source := range sources {
+		f, err := parser.ParseFile(fset, source, nil, parser.ParseComments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				name := typeSpec.Name.Name
+				g.types[name] = typeSpec
+				g.docs[name] = gen.Doc.Text()
+				if _, ok := typeSpec.Type.(*ast.StructType); ok && source == "entity.go" && strings.HasSuffix(name, "Entity") {
+					roots = append(roots, name)
+				}
+			}
+		}
+	}
+
+	for _, root := range roots {
+		g.message(root).root = true
+	}
+
+	if err := write(protoFile, g.proto(), false); err != nil {
+		log.Fatal(err)
+	}
+	if err := write(convertFile, g.convert(), true); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func write(path string, src []byte, gofmt bool) error {
+	if gofmt {
+		var err error
+		if src, err = format.Source(src); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(path, src, 0644)
+}
+
+// message returns the message for a struct type, generating it and every message it contains if necessary.
+func (g *generator) message(name string) *message {
+	if m, ok := g.byName[name]; ok {
+		return m
+	}
+	m := &message{name: name, doc: g.docs[name]}
+	g.byName[name] = m
+	g.messages = append(g.messages, m)
+
+	names := map[string]bool{}
+	for _, f := range g.fields(name) {
+		if names[f.protoName] {
+			log.Fatalf("%s has more than one field named %s", name, f.protoName)
+		}
+		names[f.protoName] = true
+		m.fields = append(m.fields, f)
+	}
+	return m
+}
+
+// fields returns the fields of a struct type, with the fields of embedded structs flattened.
+func (g *generator) fields(name string) []field {
+	spec, ok := g.types[name]
+	if !ok {
+		log.Fatalf("unknown type %s", name)
+	}
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		log.Fatalf("%s is not a struct", name)
+	}
+
+	var fields []field
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			ident, ok := f.Type.(*ast.Ident)
+			if !ok {
+				log.Fatalf("unsupported embedded field in %s", name)
+			}
+			fields = append(fields, g.fields(ident.Name)...)
+			continue
+		}
+		typ := g.resolve(f.Type)
+		for _, n := range f.Names {
+			if !n.IsExported() {
+				log.Fatalf("unexported field %s.%s cannot be converted", name, n.Name)
+			}
+			protoName := snakeCase(n.Name)
+			fields = append(fields, field{
+				goName:    n.Name,
+				protoName: protoName,
+				pbName:    pbFieldName(protoName),
+				doc:       f.Doc.Text(),
+				typ:       typ,
+			})
+		}
+	}
+	return fields
+}
+
+func (g *generator) resolve(expr ast.Expr) *fieldType {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if s, ok := scalars[t.Name]; ok {
+			return &fieldType{kind: kindScalar, goType: t.Name, proto: s.proto, pbType: s.goType}
+		}
+		spec, ok := g.types[t.Name]
+		if !ok {
+			log.Fatalf("unknown type %s", t.Name)
+		}
+		if _, ok := spec.Type.(*ast.StructType); ok {
+			g.message(t.Name)
+			return &fieldType{kind: kindMessage, goType: t.Name, proto: t.Name, pbType: "*" + pbPackage + "." + t.Name}
+		}
+		underlying := g.resolve(spec.Type)
+		if underlying.kind != kindScalar {
+			log.Fatalf("unsupported named type %s", t.Name)
+		}
+		underlying.goType = t.Name
+		return underlying
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok && pkg.Name == "time" && t.Sel.Name == "Time" {
+			g.usesTime = true
+			return &fieldType{kind: kindTimestamp, goType: "time.Time", proto: "google.protobuf.Timestamp", pbType: "*timestamppb.Timestamp"}
+		}
+	case *ast.ArrayType:
+		if t.Len == nil {
+			elem := g.resolve(t.Elt)
+			if elem.kind == kindRepeated || elem.kind == kindMap {
+				log.Fatalf("unsupported nested collection %#v", t)
+			}
+			return &fieldType{kind: kindRepeated, elem: elem}
+		}
+	case *ast.MapType:
+		key, elem := g.resolve(t.Key), g.resolve(t.Value)
+		if key.kind != kindScalar || key.proto == "float" || key.proto == "double" {
+			log.Fatalf("unsupported map key %#v", t.Key)
+		}
+		if elem.kind == kindRepeated || elem.kind == kindMap {
+			log.Fatalf("unsupported map value %#v", t.Value)
+		}
+		return &fieldType{kind: kindMap, key: key, elem: elem}
+	}
+	log.Fatalf("unsupported type %#v", expr)
+	return nil
+}
+
+func (g *generator) proto() []byte {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_proto.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, `syntax = "proto3";`)
+	fmt.Fprintln(&buf, "package manifest;")
+	fmt.Fprintln(&buf)
+	if g.usesTime {
+		fmt.Fprintln(&buf, `import "google/protobuf/timestamp.proto";`)
+		fmt.Fprintln(&buf)
+	}
+	fmt.Fprintln(&buf, `option go_package = "github.com/paranoiacblack/destiny2/service/protos/manifest";`)
+
+	for _, m := range g.messages {
+		fmt.Fprintln(&buf)
+		writeComment(&buf, "", m.doc)
+		fmt.Fprintf(&buf, "message %s {\n", m.name)
+		for i, f := range m.fields {
+			writeComment(&buf, "  ", f.doc)
+			fmt.Fprintf(&buf, "  %s %s = %d;\n", protoType(f.typ), f.protoName, i+1)
+		}
+		fmt.Fprintln(&buf, "}")
+	}
+	return buf.Bytes()
+}
+
+func writeComment(buf *bytes.Buffer, indent, doc string) {
+	for _, line := range strings.Split(strings.TrimSpace(doc), "\n") {
+		if line == "" {
+			continue
+		}
+		fmt.Fprintf(buf, "%s// %s\n", indent, line)
+	}
+}
+
+func protoType(t *fieldType) string {
+	switch t.kind {
+	case kindRepeated:
+		return "repeated " + t.elem.proto
+	case kindMap:
+		return fmt.Sprintf("map<%s, %s>", t.key.proto, t.elem.proto)
+	}
+	return t.proto
+}
+
+func (g *generator) convert() []byte {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_proto.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package destiny2")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import (")
+	fmt.Fprintf(&buf, "\t%s %q\n", pbPackage, "github.com/paranoiacblack/destiny2/service/protos/manifest")
+	fmt.Fprintf(&buf, "\t%q\n", "google.golang.org/protobuf/proto")
+	if g.usesTime {
+		fmt.Fprintf(&buf, "\t%q\n", "google.golang.org/protobuf/types/known/timestamppb")
+	}
+	fmt.Fprintln(&buf, ")")
+
+	fmt.Fprint(&buf, `
+// EntityProto returns the protobuf representation of an entity, such as one returned by Contract.Entity.
+// It returns false if entity isn't an entity.
+func EntityProto(entity interface{}) (proto.Message, bool) {
+	switch e := entity.(type) {
+`)
+	for _, m := range g.messages {
+		if m.root {
+			fmt.Fprintf(&buf, "\tcase %[1]s:\n\t\treturn e.Proto(), true\n\tcase *%[1]s:\n\t\treturn e.Proto(), true\n", m.name)
+		}
+	}
+	fmt.Fprintln(&buf, "\t}\n\treturn nil, false\n}")
+
+	for _, m := range g.messages {
+		if m.root {
+			fmt.Fprintf(&buf, `
+// Proto returns the protobuf representation of this entity.
+func (e %[1]s) Proto() *%[2]s.%[1]s {
+	return toProto%[1]s(e)
+}
+
+// %[1]sFromProto returns the entity represented by p.
+func %[1]sFromProto(p *%[2]s.%[1]s) %[1]s {
+	return fromProto%[1]s(p)
+}
+`, m.name, pbPackage)
+		}
+
+		fmt.Fprintf(&buf, "\nfunc toProto%[1]s(v %[1]s) *%[2]s.%[1]s {\n\tp := &%[2]s.%[1]s{}\n", m.name, pbPackage)
+		for _, f := range m.fields {
+			writeConversion(&buf, "p."+f.pbName, "v."+f.goName, f.typ, true)
+		}
+		fmt.Fprintln(&buf, "\treturn p\n}")
+
+		fmt.Fprintf(&buf, "\nfunc fromProto%[1]s(p *%[2]s.%[1]s) %[1]s {\n\tvar v %[1]s\n\tif p == nil {\n\t\treturn v\n\t}\n", m.name, pbPackage)
+		for _, f := range m.fields {
+			writeConversion(&buf, "v."+f.goName, "p."+f.pbName, f.typ, false)
+		}
+		fmt.Fprintln(&buf, "\treturn v\n}")
+	}
+	return buf.Bytes()
+}
+
+// writeConversion writes the statements assigning src to dst, converting to protobuf if toProto is true.
+func writeConversion(buf *bytes.Buffer, dst, src string, t *fieldType, toProto bool) {
+	switch t.kind {
+	case kindRepeated:
+		fmt.Fprintf(buf, "\tfor _, x := range %s {\n\t\t%s = append(%s, %s)\n\t}\n", src, dst, dst, convertValue("x", t.elem, toProto))
+	case kindMap:
+		keyType, elemType := t.key.goType, t.elem.goType
+		if toProto {
+			keyType, elemType = t.key.pbType, t.elem.pbType
+		}
+		fmt.Fprintf(buf, "\tif len(%s) > 0 {\n\t\t%s = make(map[%s]%s, len(%s))\n\t\tfor k, x := range %s {\n\t\t\t%s[%s] = %s\n\t\t}\n\t}\n",
+			src, dst, keyType, elemType, src, src, dst, convertValue("k", t.key, toProto), convertValue("x", t.elem, toProto))
+	default:
+		fmt.Fprintf(buf, "\t%s = %s\n", dst, convertValue(src, t, toProto))
+	}
+}
+
+// convertValue returns an expression converting a scalar, message or timestamp.
+func convertValue(expr string, t *fieldType, toProto bool) string {
+	switch t.kind {
+	case kindMessage:
+		if toProto {
+			return fmt.Sprintf("toProto%s(%s)", t.goType, expr)
+		}
+		return fmt.Sprintf("fromProto%s(%s)", t.goType, expr)
+	case kindTimestamp:
+		if toProto {
+			return fmt.Sprintf("timestamppb.New(%s)", expr)
+		}
+		return fmt.Sprintf("fromProtoTime(%s)", expr)
+	}
+	if t.goType == t.pbType {
+		return expr
+	}
+	if toProto {
+		return fmt.Sprintf("%s(%s)", t.pbType, expr)
+	}
+	return fmt.Sprintf("%s(%s)", t.goType, expr)
+}
+
+// snakeCase converts a Go field name to a protobuf field name, such as "UiItemDisplayStyle" to "ui_item_display_style".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// pbFieldName is the name protoc-gen-go gives the Go field for a protobuf field.
+func pbFieldName(protoName string) string {
+	var b []byte
+	for i := 0; i < len(protoName); i++ {
+		c := protoName[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(protoName) && 'a' <= protoName[i+1] && protoName[i+1] <= 'z':
+		case '0' <= c && c <= '9':
+			b = append(b, c)
+		default:
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(protoName) && 'a' <= protoName[i+1] && protoName[i+1] <= 'z'; i++ {
+				b = append(b, protoName[i+1])
+			}
+		}
+	}
+	name := string(b)
+	switch name {
+	case "Reset", "String", "ProtoMessage", "ProtoReflect", "Descriptor":
+		name += "_"
+	}
+	return name
+}
@@ -0,0 +1,223 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/paranoiacblack/destiny2"
+)
+
+// fakeContracts provides a fixed set of entities, named after the requested locale.
+type fakeContracts struct {
+	fulfilled map[string]int
+}
+
+func newFakeContracts() *fakeContracts {
+	return &fakeContracts{fulfilled: map[string]int{}}
+}
+
+func (c *fakeContracts) Version() string {
+	return "1"
+}
+
+func (c *fakeContracts) Contract(name, locale string) (destiny2.Contract, error) {
+	c.fulfilled[name]++
+	switch name {
+	case "DestinyInventoryItemDefinition":
+		weapon := destiny2.InventoryItemEntity{
+			DisplayProperties: destiny2.DisplayProperties{Name: locale + " Ace of Spades"},
+			EntityMetadata:    destiny2.EntityMetadata{Hash: 1},
+		}
+		weapon.Sockets.SocketEntries = []destiny2.ItemSocketEntry{
+			{SingleInitialItemHash: 2, ReusablePlugSetHash: 10},
+			{SingleInitialItemHash: 3, RandomizedPlugSetHash: 11},
+		}
+		return &destiny2.InventoryItemDefinition{
+			1: weapon,
+			2: {DisplayProperties: destiny2.DisplayProperties{Name: locale + " Memento Mori"}, EntityMetadata: destiny2.EntityMetadata{Hash: 2}},
+			3: {DisplayProperties: destiny2.DisplayProperties{Name: locale + " Firefly"}, EntityMetadata: destiny2.EntityMetadata{Hash: 3}},
+			4: {DisplayProperties: destiny2.DisplayProperties{Name: locale + " Outlaw"}, EntityMetadata: destiny2.EntityMetadata{Hash: 4}},
+		}, nil
+	case "DestinyPlugSetDefinition":
+		return &destiny2.PlugSetDefinition{
+			10: {ReusablePlugItems: []destiny2.ItemSocketEntryPlugItemRandomized{{PlugItemHash: 2}}, EntityMetadata: destiny2.EntityMetadata{Hash: 10}},
+			11: {ReusablePlugItems: []destiny2.ItemSocketEntryPlugItemRandomized{{PlugItemHash: 3}, {PlugItemHash: 4}, {PlugItemHash: 99}}, EntityMetadata: destiny2.EntityMetadata{Hash: 11}},
+		}, nil
+	}
+	return nil, fmt.Errorf("%s is not in the fake manifest", name)
+}
+
+const itemQuery = `{
+	inventoryItem(hash: 1) {
+		name: displayProperties { name }
+		sockets {
+			socketEntries {
+				singleInitialItem { displayProperties { name } }
+				reusablePlugSet { reusablePlugItems { plugItem { hash displayProperties { name } } } }
+				randomizedPlugSet { reusablePlugItems { plugItem { hash displayProperties { name } } } }
+			}
+		}
+	}
+}`
+
+func TestQuery(t *testing.T) {
+	c := newFakeContracts()
+	h, err := NewHandler(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := h.Do(context.Background(), itemQuery, nil, "en")
+	if result.HasErrors() {
+		t.Fatalf("Do() = %v", result.Errors)
+	}
+	got, err := json.Marshal(result.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := func(n string) string { return fmt.Sprintf(`{"name":%q}`, n) }
+	plug := func(hash int, n string) string {
+		if n == "" {
+			return `{"plugItem":null}`
+		}
+		return fmt.Sprintf(`{"plugItem":{"displayProperties":%s,"hash":%d}}`, name(n), hash)
+	}
+	want := `{"inventoryItem":{"name":` + name("en Ace of Spades") + `,"sockets":{"socketEntries":[` +
+		`{"randomizedPlugSet":null,"reusablePlugSet":{"reusablePlugItems":[` + plug(2, "en Memento Mori") + `]},` +
+		`"singleInitialItem":{"displayProperties":` + name("en Memento Mori") + `}},` +
+		`{"randomizedPlugSet":{"reusablePlugItems":[` + plug(3, "en Firefly") + `,` + plug(4, "en Outlaw") + `,` + plug(99, "") + `]},` +
+		`"reusablePlugSet":null,"singleInitialItem":{"displayProperties":` + name("en Firefly") + `}}]}}}`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("Do() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Lookups are batched: the item, then both plug sets and both initial plugs together, then every plug in the plug sets.
+	wantCalls := map[string]int{"DestinyInventoryItemDefinition": 3, "DestinyPlugSetDefinition": 1}
+	if diff := cmp.Diff(wantCalls, c.fulfilled); diff != "" {
+		t.Errorf("Contract() calls mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestQuery_Search(t *testing.T) {
+	h, err := NewHandler(newFakeContracts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := h.Do(context.Background(), `{ inventoryItems(search: "OUT") { hash } version }`, nil, "en")
+	if result.HasErrors() {
+		t.Fatalf("Do() = %v", result.Errors)
+	}
+	got, err := json.Marshal(result.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"inventoryItems":[{"hash":4}],"version":"1"}`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("Do() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	h, err := NewHandler(newFakeContracts())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	query := `query($hash: Long!) { inventoryItem(hash: $hash) { displayProperties { name } } }`
+	body, _ := json.Marshal(map[string]interface{}{"query": query, "variables": map[string]interface{}{"hash": 4}})
+
+	tests := []struct {
+		name   string
+		req    func() (*http.Request, error)
+		want   string
+		status int
+	}{
+		{
+			name: "GET with locale parameter",
+			req: func() (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, srv.URL+"?locale=de&variables=%7B%22hash%22%3A4%7D&query="+url.QueryEscape(query), nil)
+			},
+			want:   "de Outlaw",
+			status: http.StatusOK,
+		},
+		{
+			name: "POST with Accept-Language",
+			req: func() (*http.Request, error) {
+				req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(string(body)))
+				if err == nil {
+					req.Header.Set("Accept-Language", "fr-CA, en;q=0.5")
+				}
+				return req, err
+			},
+			want:   "fr Outlaw",
+			status: http.StatusOK,
+		},
+		{
+			name: "POST defaults to English",
+			req: func() (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(string(body)))
+			},
+			want:   "en Outlaw",
+			status: http.StatusOK,
+		},
+		{
+			name: "invalid body",
+			req: func() (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("{"))
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := test.req()
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != test.status {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, test.status)
+			}
+			if test.status != http.StatusOK {
+				return
+			}
+
+			var got struct {
+				Data struct {
+					InventoryItem struct {
+						DisplayProperties struct {
+							Name string
+						}
+					}
+				}
+				Errors []interface{}
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if len(got.Errors) > 0 {
+				t.Fatalf("errors = %v", got.Errors)
+			}
+			if name := got.Data.InventoryItem.DisplayProperties.Name; name != test.want {
+				t.Errorf("name = %q, want %q", name, test.want)
+			}
+		})
+	}
+}
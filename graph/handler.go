@@ -0,0 +1,101 @@
+// Package graph serves the Destiny 2 manifest as a GraphQL API.
+//
+// The schema is derived from the entity types: every contract has a query for a single entity by hash and for
+// a list of entities by hashes or name, and every hash field that refers to another entity, such as
+// PlugSetHash or ObjectiveHashes, has a matching field resolving that entity, such as plugSet or objectives.
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+
+	"github.com/paranoiacblack/destiny2"
+)
+
+// Contracts provides fulfilled contracts to a Handler, typically a *destiny2.ContractCache.
+type Contracts interface {
+	// Version is the version of the manifest the contracts are from.
+	Version() string
+	// Contract returns the fulfilled contract with a given name in a Bungie.net locale, such as "en".
+	Contract(name, locale string) (destiny2.Contract, error)
+}
+
+// Handler executes GraphQL queries against the manifest.
+type Handler struct {
+	schema    graphql.Schema
+	contracts Contracts
+}
+
+// NewHandler returns a Handler for contracts.
+func NewHandler(contracts Contracts) (*Handler, error) {
+	schema, err := newSchema()
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{schema: schema, contracts: contracts}, nil
+}
+
+// Schema returns the GraphQL schema derived from the entity types.
+func (h *Handler) Schema() graphql.Schema {
+	return h.schema
+}
+
+// Do executes a query with entities in a Bungie.net locale, such as "en".
+func (h *Handler) Do(ctx context.Context, query string, variables map[string]interface{}, locale string) *graphql.Result {
+	tag, err := destiny2.LocaleTag(locale)
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	return graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  query,
+		VariableValues: variables,
+		Context:        withLoader(ctx, newLoader(h.contracts, destiny2.BungieLocale(tag))),
+	})
+}
+
+// request is the body of a GraphQL request sent with POST.
+type request struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// ServeHTTP executes a query sent as the query parameter of a GET request or in the JSON body of a POST request.
+// The locale is the locale parameter if present, otherwise the best match for the Accept-Language header.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				http.Error(w, fmt.Sprintf("invalid variables: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "only GET and POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		tag, _ := destiny2.NegotiateLocale(r.Header.Get("Accept-Language"))
+		locale = destiny2.BungieLocale(tag)
+	}
+
+	result := h.Do(r.Context(), req.Query, req.Variables, locale)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
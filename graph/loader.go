@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/paranoiacblack/destiny2"
+)
+
+// loader batches entity lookups within a single request. Resolvers queue the hashes they need and return thunks;
+// the first thunk to run looks up every queued hash, one contract at a time, so a query touching many entities
+// fulfills each contract once rather than once per entity.
+type loader struct {
+	contracts Contracts
+	locale    string
+
+	mu      sync.Mutex
+	pending map[string][]uint32
+	loaded  map[string]map[uint32]interface{}
+	err     error
+}
+
+type loaderKey struct{}
+
+func withLoader(ctx context.Context, l *loader) context.Context {
+	return context.WithValue(ctx, loaderKey{}, l)
+}
+
+func requestLoader(ctx context.Context) *loader {
+	return ctx.Value(loaderKey{}).(*loader)
+}
+
+func newLoader(contracts Contracts, locale string) *loader {
+	return &loader{
+		contracts: contracts,
+		locale:    locale,
+		pending:   map[string][]uint32{},
+		loaded:    map[string]map[uint32]interface{}{},
+	}
+}
+
+func (l *loader) version() string {
+	return l.contracts.Version()
+}
+
+// load queues a hash and returns a thunk resolving to its entity, or nil if there is no such entity.
+func (l *loader) load(contract string, hash uint32) func() (interface{}, error) {
+	l.enqueue(contract, hash)
+	return func() (interface{}, error) {
+		if err := l.dispatch(); err != nil {
+			return nil, err
+		}
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if entity, ok := l.loaded[contract][hash]; ok {
+			return entity, nil
+		}
+		return nil, nil
+	}
+}
+
+// loadMany queues hashes and returns a thunk resolving to their entities, skipping any that don't exist.
+func (l *loader) loadMany(contract string, hashes []uint32) func() (interface{}, error) {
+	for _, hash := range hashes {
+		l.enqueue(contract, hash)
+	}
+	return func() (interface{}, error) {
+		if err := l.dispatch(); err != nil {
+			return nil, err
+		}
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		entities := []interface{}{}
+		for _, hash := range hashes {
+			if entity, ok := l.loaded[contract][hash]; ok {
+				entities = append(entities, entity)
+			}
+		}
+		return entities, nil
+	}
+}
+
+// search returns a thunk resolving to the entities in a contract whose names contain query.
+func (l *loader) search(contract, query string) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		c, err := l.contracts.Contract(contract, l.locale)
+		if err != nil {
+			return nil, err
+		}
+		entities := []interface{}{}
+		for _, hash := range destiny2.SearchContract(c, query) {
+			entity, _ := destiny2.FindEntity(c, hash)
+			entities = append(entities, entity)
+		}
+		return entities, nil
+	}
+}
+
+func (l *loader) enqueue(contract string, hash uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.loaded[contract][hash]; ok {
+		return
+	}
+	l.pending[contract] = append(l.pending[contract], hash)
+}
+
+// dispatch looks up every queued hash.
+func (l *loader) dispatch() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for name, hashes := range l.pending {
+		delete(l.pending, name)
+		contract, err := l.contracts.Contract(name, l.locale)
+		if err != nil {
+			l.err = err
+			continue
+		}
+
+		loaded, ok := l.loaded[name]
+		if !ok {
+			loaded = map[uint32]interface{}{}
+			l.loaded[name] = loaded
+		}
+		for _, hash := range hashes {
+			if entity, ok := destiny2.FindEntity(contract, hash); ok {
+				loaded[hash] = entity
+			}
+		}
+	}
+	return l.err
+}
@@ -0,0 +1,433 @@
+package graph
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+
+	"github.com/paranoiacblack/destiny2"
+)
+
+// Long is a scalar for unsigned 32-bit and 64-bit integers, such as hashes, which don't fit in a GraphQL Int.
+var Long = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Long",
+	Description: "An integer that may not fit in 32 bits, such as an entity hash.",
+	Serialize: func(value interface{}) interface{} {
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return v.Uint()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return v.Int()
+		}
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case int:
+			return uint64(v)
+		case float64:
+			return uint64(v)
+		case string:
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil
+			}
+			return n
+		}
+		return nil
+	},
+	ParseLiteral: func(value ast.Value) interface{} {
+		switch v := value.(type) {
+		case *ast.IntValue:
+			n, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			return n
+		case *ast.StringValue:
+			n, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				return nil
+			}
+			return n
+		}
+		return nil
+	},
+})
+
+// relationAliases maps the names used by hash fields to the entities they refer to, when they differ.
+var relationAliases = map[string]string{
+	"Item":       "InventoryItem",
+	"Perk":       "SandboxPerk",
+	"Bucket":     "InventoryBucket",
+	"BucketType": "InventoryBucket",
+	"StatType":   "Stat",
+	"Node":       "PresentationNode",
+}
+
+// entityContract describes the contract containing an entity type.
+type entityContract struct {
+	// name is the name of the contract, such as "DestinyInventoryItemDefinition".
+	name string
+	// base is the name of the entity without its Entity suffix, such as "InventoryItem".
+	base string
+	typ  reflect.Type
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// schemaBuilder derives GraphQL types from entity types.
+type schemaBuilder struct {
+	entities []entityContract
+	byBase   map[string]entityContract
+	objects  map[reflect.Type]*graphql.Object
+	entries  map[string]*graphql.Object
+}
+
+// newSchema returns a schema with a query for every contract and an object for every entity, with fields
+// that follow hashes to related entities.
+func newSchema() (graphql.Schema, error) {
+	b := &schemaBuilder{
+		byBase:  map[string]entityContract{},
+		objects: map[reflect.Type]*graphql.Object{},
+		entries: map[string]*graphql.Object{},
+	}
+	for _, contract := range destiny2.AllContracts() {
+		typ := reflect.TypeOf(contract).Elem().Elem()
+		ec := entityContract{name: contract.Name(), base: strings.TrimSuffix(typ.Name(), "Entity"), typ: typ}
+		b.entities = append(b.entities, ec)
+		b.byBase[ec.base] = ec
+	}
+
+	fields := graphql.Fields{
+		"version": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "The version of the manifest.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return requestLoader(p.Context).version(), nil
+			},
+		},
+	}
+	for _, ec := range b.entities {
+		ec := ec
+		object := b.object(ec.typ)
+		single := lowerCamel(ec.base)
+		fields[single] = &graphql.Field{
+			Type:        object,
+			Description: fmt.Sprintf("The entity in %s with a given hash.", ec.name),
+			Args: graphql.FieldConfigArgument{
+				"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(Long)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return requestLoader(p.Context).load(ec.name, uint32(p.Args["hash"].(uint64))), nil
+			},
+		}
+		fields[plural(single)] = &graphql.Field{
+			Type:        graphql.NewList(object),
+			Description: fmt.Sprintf("Entities in %s with the given hashes or whose names contain search, ignoring case.", ec.name),
+			Args: graphql.FieldConfigArgument{
+				"hashes": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(Long))},
+				"search": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				l := requestLoader(p.Context)
+				if search, ok := p.Args["search"].(string); ok {
+					return l.search(ec.name, search), nil
+				}
+				var hashes []uint32
+				if args, ok := p.Args["hashes"].([]interface{}); ok {
+					for _, h := range args {
+						hashes = append(hashes, uint32(h.(uint64)))
+					}
+				}
+				return l.loadMany(ec.name, hashes), nil
+			},
+		}
+	}
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: fields}),
+	})
+}
+
+// object returns the GraphQL object for a struct type, creating it if necessary.
+func (b *schemaBuilder) object(typ reflect.Type) *graphql.Object {
+	if object, ok := b.objects[typ]; ok {
+		return object
+	}
+	object := graphql.NewObject(graphql.ObjectConfig{
+		Name: typ.Name(),
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return b.fields(typ)
+		}),
+	})
+	b.objects[typ] = object
+	return object
+}
+
+// fields returns the fields of a struct type, with embedded structs flattened and a related entity field for each hash.
+func (b *schemaBuilder) fields(typ reflect.Type) graphql.Fields {
+	fields := graphql.Fields{}
+	var relations []func()
+	for _, sf := range structFields(typ, nil) {
+		sf := sf
+		name := lowerCamel(sf.Name)
+		index := sf.Index
+		output := b.output(typ.Name()+sf.Name, sf.Type)
+		fields[name] = &graphql.Field{
+			Type: output,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return resolveValue(reflect.ValueOf(p.Source).FieldByIndex(index)), nil
+			},
+		}
+
+		if ec, many, ok := b.relation(sf); ok {
+			relations = append(relations, func() {
+				b.addRelation(fields, sf, index, ec, many)
+			})
+		}
+	}
+	// Relations are added last so they never replace a field of the same name.
+	for _, add := range relations {
+		add()
+	}
+	return fields
+}
+
+// addRelation adds a field resolving the entity or entities referred to by a hash field.
+func (b *schemaBuilder) addRelation(fields graphql.Fields, sf reflect.StructField, index []int, ec entityContract, many bool) {
+	object := b.object(ec.typ)
+	if many {
+		name := plural(lowerCamel(strings.TrimSuffix(sf.Name, "Hashes")))
+		if _, ok := fields[name]; ok {
+			name = lowerCamel(strings.TrimSuffix(sf.Name, "Hashes")) + "Entities"
+		}
+		fields[name] = &graphql.Field{
+			Type:        graphql.NewList(object),
+			Description: fmt.Sprintf("The entities in %s referred to by %s.", ec.name, lowerCamel(sf.Name)),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				hashes := reflect.ValueOf(p.Source).FieldByIndex(index)
+				list := make([]uint32, hashes.Len())
+				for i := range list {
+					list[i] = uint32(hashes.Index(i).Uint())
+				}
+				return requestLoader(p.Context).loadMany(ec.name, list), nil
+			},
+		}
+		return
+	}
+
+	name := lowerCamel(strings.TrimSuffix(sf.Name, "Hash"))
+	if _, ok := fields[name]; ok {
+		name += "Entity"
+	}
+	fields[name] = &graphql.Field{
+		Type:        object,
+		Description: fmt.Sprintf("The entity in %s referred to by %s.", ec.name, lowerCamel(sf.Name)),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			hash := uint32(reflect.ValueOf(p.Source).FieldByIndex(index).Uint())
+			if hash == 0 {
+				return nil, nil
+			}
+			return requestLoader(p.Context).load(ec.name, hash), nil
+		},
+	}
+}
+
+// relation returns the contract referred to by a hash field, such as PlugSetHash or ObjectiveHashes.
+// The longest suffix of the field's name that names an entity is used, so ReusablePlugSetHash refers to a PlugSetEntity.
+func (b *schemaBuilder) relation(sf reflect.StructField) (entityContract, bool, bool) {
+	var base string
+	var many bool
+	switch {
+	case sf.Type.Kind() == reflect.Uint32 && strings.HasSuffix(sf.Name, "Hash"):
+		base = strings.TrimSuffix(sf.Name, "Hash")
+	case sf.Type.Kind() == reflect.Slice && sf.Type.Elem().Kind() == reflect.Uint32 && strings.HasSuffix(sf.Name, "Hashes"):
+		base, many = strings.TrimSuffix(sf.Name, "Hashes"), true
+	default:
+		return entityContract{}, false, false
+	}
+
+	words := camelWords(base)
+	for i := range words {
+		candidate := strings.Join(words[i:], "")
+		if alias, ok := relationAliases[candidate]; ok {
+			candidate = alias
+		}
+		if ec, ok := b.byBase[candidate]; ok {
+			return ec, many, true
+		}
+	}
+	return entityContract{}, false, false
+}
+
+// output returns the GraphQL type for a Go type. Maps become lists of key/value entries named after name.
+func (b *schemaBuilder) output(name string, typ reflect.Type) graphql.Output {
+	if typ == timeType {
+		return graphql.DateTime
+	}
+	if typ.Implements(stringerType) {
+		return graphql.String
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return graphql.Boolean
+	case reflect.String:
+		return graphql.String
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int, reflect.Uint8, reflect.Uint16:
+		return graphql.Int
+	case reflect.Uint32, reflect.Uint64, reflect.Uint, reflect.Int64:
+		return Long
+	case reflect.Float32, reflect.Float64:
+		return graphql.Float
+	case reflect.Slice:
+		return graphql.NewList(b.output(name, typ.Elem()))
+	case reflect.Map:
+		return graphql.NewList(b.entry(name+"Entry", typ))
+	case reflect.Struct:
+		return b.object(typ)
+	}
+	panic(fmt.Sprintf("graph: unsupported type %s", typ))
+}
+
+// mapEntry is a single entry of a map field.
+type mapEntry struct {
+	key, value reflect.Value
+}
+
+// entry returns the GraphQL object for an entry of a map type.
+func (b *schemaBuilder) entry(name string, typ reflect.Type) *graphql.Object {
+	if object, ok := b.entries[name]; ok {
+		return object
+	}
+	object := graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"key": &graphql.Field{
+					Type: b.output(name+"Key", typ.Key()),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return resolveValue(p.Source.(mapEntry).key), nil
+					},
+				},
+				"value": &graphql.Field{
+					Type: b.output(name+"Value", typ.Elem()),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return resolveValue(p.Source.(mapEntry).value), nil
+					},
+				},
+			}
+		}),
+	})
+	b.entries[name] = object
+	return object
+}
+
+// resolveValue converts a field to a value GraphQL can serialize.
+func resolveValue(v reflect.Value) interface{} {
+	if v.Type() != timeType && v.Type().Implements(stringerType) {
+		return v.Interface().(fmt.Stringer).String()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			list[i] = resolveValue(v.Index(i))
+		}
+		return list
+	case reflect.Map:
+		entries := make([]interface{}, 0, v.Len())
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			entries = append(entries, mapEntry{key, v.MapIndex(key)})
+		}
+		return entries
+	case reflect.Struct:
+		return v.Interface()
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int, reflect.Int64:
+		return v.Int()
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+	return v.Interface()
+}
+
+// structFields returns the exported fields of a struct type, with the fields of embedded structs flattened.
+func structFields(typ reflect.Type, index []int) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		sf.Index = append(append([]int{}, index...), i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, structFields(sf.Type, sf.Index)...)
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		fields = append(fields, sf)
+	}
+	return fields
+}
+
+// camelWords splits a Go identifier into words, such as "ReusablePlugSet" into "Reusable", "Plug" and "Set".
+func camelWords(name string) []string {
+	var words []string
+	start := 0
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+	return append(words, name[start:])
+}
+
+// lowerCamel converts a Go identifier to a GraphQL field name, such as "UiItemDisplayStyle" to "uiItemDisplayStyle".
+func lowerCamel(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		// Keep the last capital of a leading acronym when it starts the next word, as in "PGCRImage".
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// plural returns the plural of a field name, such as "activity" to "activities".
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "s"):
+		return name + "es"
+	}
+	return name + "s"
+}
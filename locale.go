@@ -0,0 +1,244 @@
+package destiny2
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// bungieLocales are the language/locale codes used by Bungie.net, in the same order as supportedTags.
+var bungieLocales = []string{
+	"en",
+	"es",
+	"fr",
+	"es-mx",
+	"de",
+	"it",
+	"ja",
+	"pt-br",
+	"ru",
+	"pl",
+	"ko",
+	"zh-cht",
+	"zh-chs",
+}
+
+// supportedTags are the language.Tag values for each of the Bungie.net locales.
+var supportedTags = []language.Tag{
+	language.English, // Used as a fallback if no language option is provided.
+	language.Spanish,
+	language.French,
+	language.MustParse("es-mx"),
+	language.German,
+	language.Italian,
+	language.Japanese,
+	language.BrazilianPortuguese,
+	language.Russian,
+	language.Polish,
+	language.Korean,
+	language.TraditionalChinese,
+	language.SimplifiedChinese,
+}
+
+var supportedLanguages = language.NewMatcher(supportedTags)
+
+// LocaleError represents an error finding a user-specified locale.
+type LocaleError struct {
+	locale string
+}
+
+func (e LocaleError) Error() string {
+	return fmt.Sprintf("%q is unsupported by the Bungie API", e.locale)
+}
+
+// SupportedLocales returns every language/locale supported by the Bungie API.
+// English is always first.
+func SupportedLocales() []language.Tag {
+	return append([]language.Tag(nil), supportedTags...)
+}
+
+// BungieLocale returns the Bungie.net locale code for a supported tag, such as "zh-chs" for language.SimplifiedChinese.
+// Tags that are not exactly supported are matched to the closest supported language/locale.
+func BungieLocale(tag language.Tag) string {
+	_, index, _ := supportedLanguages.Match(tag)
+	return bungieLocales[index]
+}
+
+// LocaleTag returns the language.Tag for a Bungie.net locale code, such as language.BrazilianPortuguese for "pt-br".
+func LocaleTag(locale string) (language.Tag, error) {
+	for i, code := range bungieLocales {
+		if strings.EqualFold(code, locale) {
+			return supportedTags[i], nil
+		}
+	}
+	return language.Und, LocaleError{locale}
+}
+
+// NegotiateLocale returns the supported language/locale that best matches an HTTP Accept-Language header value,
+// honoring quality weights. English is returned if no requested language/locale is supported.
+func NegotiateLocale(acceptLanguage string) (language.Tag, error) {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return language.English, err
+	}
+	if len(tags) == 0 {
+		return language.English, nil
+	}
+
+	_, index, confidence := supportedLanguages.Match(tags...)
+	if confidence == language.No {
+		return language.English, nil
+	}
+	return supportedTags[index], nil
+}
+
+// Returns a language.Tag that matches Bungie-supported locales.
+func getSupportedTagForLocale(locale string) language.Tag {
+	if tag, err := LocaleTag(locale); err == nil {
+		return tag
+	}
+
+	_, index, _ := supportedLanguages.Match(language.Make(locale))
+	return supportedTags[index]
+}
+
+// LocalizedDisplayProperties maps the hash of an entity to its DisplayProperties in each fulfilled language/locale.
+type LocalizedDisplayProperties map[uint32]map[language.Tag]DisplayProperties
+
+// FulfillContractLocales fulfills a contract once for each of the given languages/locales and
+// returns the DisplayProperties of every entity in each of them.
+// If no locales are given, the contract is fulfilled in every language/locale found in the manifest.
+// Locales are Bungie.net locale codes such as "pt-br"; a LocaleError is returned for any other locale.
+// English is always fulfilled as it is the reference used by LocalizedDisplayProperties.Report.
+// The definition itself is left fulfilled in English.
+func (m *Manifest) FulfillContractLocales(definition Contract, locales []string, opts ...FulfillmentOption) (LocalizedDisplayProperties, error) {
+	tags := []language.Tag{language.English}
+	if len(locales) == 0 {
+		for tag := range m.contracts {
+			if tag != language.English {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	for _, locale := range locales {
+		tag, err := LocaleTag(locale)
+		if err != nil {
+			return nil, err
+		}
+		if tag != language.English {
+			tags = append(tags, tag)
+		}
+	}
+
+	localized := LocalizedDisplayProperties{}
+	for _, tag := range tags {
+		contract := definition
+		if tag != language.English {
+			contract = reflect.New(reflect.TypeOf(definition).Elem()).Interface().(Contract)
+		}
+
+		tagOpts := append(append([]FulfillmentOption{}, opts...), withTag(tag))
+		if err := m.FulfillContract(contract, tagOpts...); err != nil {
+			return nil, err
+		}
+
+		for hash, entity := range ContractEntities(contract) {
+			props, ok := EntityDisplayProperties(entity)
+			if !ok {
+				continue
+			}
+			if _, ok := localized[hash]; !ok {
+				localized[hash] = map[language.Tag]DisplayProperties{}
+			}
+			localized[hash][tag] = props
+		}
+	}
+	return localized, nil
+}
+
+// withTag fulfills a contract using an already supported language.Tag.
+func withTag(tag language.Tag) FulfillmentOption {
+	return func(o *fulfillmentOptions) error {
+		o.tag = tag
+		return nil
+	}
+}
+
+// LocalizationIssueKind classifies a problem found with a localized entity.
+type LocalizationIssueKind int
+
+const (
+	// LocalizationMissing means the entity has no name in a locale, but does in English.
+	LocalizationMissing LocalizationIssueKind = iota
+	// LocalizationUntranslated means the entity has the same name in a locale as it does in English.
+	LocalizationUntranslated
+)
+
+func (k LocalizationIssueKind) String() string {
+	switch k {
+	case LocalizationMissing:
+		return "missing"
+	case LocalizationUntranslated:
+		return "untranslated"
+	}
+	return "unknown"
+}
+
+// LocalizationIssue describes an entity whose name is inconsistent between English and another locale.
+type LocalizationIssue struct {
+	// Hash is the hash of the entity.
+	Hash uint32
+	// Locale is the language/locale with the issue.
+	Locale language.Tag
+	// Kind is the classification of this issue.
+	Kind LocalizationIssueKind
+	// English is the English name of the entity.
+	English string
+	// Name is the name of the entity in Locale.
+	Name string
+}
+
+// Report returns every entity whose name is missing or identical to English in another locale.
+// Entities without an English name are skipped. Issues are sorted by hash and then by locale.
+func (l LocalizedDisplayProperties) Report() []LocalizationIssue {
+	tags := map[language.Tag]bool{}
+	for _, locales := range l {
+		for tag := range locales {
+			tags[tag] = true
+		}
+	}
+
+	var issues []LocalizationIssue
+	for hash, locales := range l {
+		english := locales[language.English].Name
+		if english == "" {
+			continue
+		}
+
+		for tag := range tags {
+			if tag == language.English {
+				continue
+			}
+
+			// Entities absent from a locale's contract are treated as missing a name.
+			props := locales[tag]
+			switch props.Name {
+			case "":
+				issues = append(issues, LocalizationIssue{Hash: hash, Locale: tag, Kind: LocalizationMissing, English: english})
+			case english:
+				issues = append(issues, LocalizationIssue{Hash: hash, Locale: tag, Kind: LocalizationUntranslated, English: english, Name: props.Name})
+			}
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Hash != issues[j].Hash {
+			return issues[i].Hash < issues[j].Hash
+		}
+		return issues[i].Locale.String() < issues[j].Locale.String()
+	})
+	return issues
+}
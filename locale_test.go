@@ -0,0 +1,117 @@
+package destiny2
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/text/language"
+)
+
+// pathReader implements ContractReader by serving fixed data for each contract path.
+type pathReader map[string]string
+
+func (r pathReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	data, ok := r[path]
+	if !ok {
+		return nil, fmt.Errorf("no contract at %q", path)
+	}
+	return []byte(data), nil
+}
+
+func (r pathReader) Close() error {
+	return nil
+}
+
+func TestFulfillContractLocales(t *testing.T) {
+	genders := GenderDefinition{}.Name()
+	manifest := &Manifest{
+		contracts: map[language.Tag]map[string]string{
+			language.English: {genders: "/en"},
+			language.German:  {genders: "/de"},
+			language.French:  {genders: "/fr"},
+		},
+		contractReader: pathReader{
+			"/en": `{"1": {"hash": 1, "displayProperties": {"name": "Masculine"}}, "2": {"hash": 2, "displayProperties": {"name": "Feminine"}}}`,
+			"/de": `{"1": {"hash": 1, "displayProperties": {"name": "Männlich"}}, "2": {"hash": 2, "displayProperties": {"name": ""}}}`,
+			"/fr": `{"1": {"hash": 1, "displayProperties": {"name": "Masculine"}}}`,
+		},
+	}
+
+	var definition GenderDefinition
+	localized, err := manifest.FulfillContractLocales(&definition, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := definition[1].DisplayProperties.Name; got != "Masculine" {
+		t.Errorf("definition should be fulfilled in English: got %q", got)
+	}
+	if got := localized[1][language.German].Name; got != "Männlich" {
+		t.Errorf("German name for hash 1: got %q, want %q", got, "Männlich")
+	}
+
+	want := []LocalizationIssue{
+		{Hash: 1, Locale: language.French, Kind: LocalizationUntranslated, English: "Masculine", Name: "Masculine"},
+		{Hash: 2, Locale: language.German, Kind: LocalizationMissing, English: "Feminine"},
+		{Hash: 2, Locale: language.French, Kind: LocalizationMissing, English: "Feminine"},
+	}
+	if diff := cmp.Diff(want, localized.Report(), cmp.Comparer(func(a, b language.Tag) bool { return a == b })); diff != "" {
+		t.Errorf("Report differs: %s", diff)
+	}
+}
+
+func TestFulfillContractLocales_Unsupported(t *testing.T) {
+	manifest := &Manifest{contracts: map[language.Tag]map[string]string{language.English: {}}}
+	var definition GenderDefinition
+	_, err := manifest.FulfillContractLocales(&definition, []string{"fr", "tlh"})
+	if !errors.As(err, &LocaleError{}) {
+		t.Errorf("FulfillContractLocales(tlh) = %v, want LocaleError", err)
+	}
+}
+
+func TestNegotiateLocale(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           language.Tag
+	}{
+		{"", language.English},
+		{"de-DE,de;q=0.9,en;q=0.8", language.German},
+		{"en;q=0.5,fr;q=0.9", language.French},
+		{"es-MX,es;q=0.9", language.MustParse("es-mx")},
+		{"pt-BR", language.BrazilianPortuguese},
+		{"zh-TW", language.TraditionalChinese},
+		{"zh-CN", language.SimplifiedChinese},
+		{"tlh", language.English},
+	}
+
+	for _, test := range tests {
+		got, err := NegotiateLocale(test.acceptLanguage)
+		if err != nil {
+			t.Errorf("NegotiateLocale(%q): %v", test.acceptLanguage, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("NegotiateLocale(%q): got %s, want %s", test.acceptLanguage, got, test.want)
+		}
+	}
+}
+
+func TestBungieLocale(t *testing.T) {
+	for _, tag := range SupportedLocales() {
+		locale := BungieLocale(tag)
+		got, err := LocaleTag(locale)
+		if err != nil {
+			t.Errorf("LocaleTag(%q): %v", locale, err)
+			continue
+		}
+		if got != tag {
+			t.Errorf("LocaleTag(BungieLocale(%s)): got %s", tag, got)
+		}
+	}
+
+	if _, err := LocaleTag("tlh"); err == nil {
+		t.Error(`LocaleTag("tlh") should be unsupported`)
+	}
+}
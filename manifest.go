@@ -2,36 +2,77 @@ package destiny2
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io/ioutil"
-	"net/http"
+	"time"
 
 	"golang.org/x/text/language"
 )
 
-var supportedLanguages = language.NewMatcher([]language.Tag{
-	language.English, // Used as a fallback if no language option is provided.
-	language.Spanish,
-	language.French,
-	language.MustParse("es-mx"),
-	language.German,
-	language.Italian,
-	language.Japanese,
-	language.BrazilianPortuguese,
-	language.Russian,
-	language.Polish,
-	language.Korean,
-	language.TraditionalChinese,
-	language.SimplifiedChinese,
-})
-
-// LocaleError represents an error finding a user-specified locale.
-type LocaleError struct {
-	locale string
+// ErrorCode is a PlatformErrorCode returned from the Bungie.net API, as defined in the service package.
+type ErrorCode int32
+
+const (
+	ErrorCode_Success                            ErrorCode = 1
+	ErrorCode_SystemDisabled                     ErrorCode = 5
+	ErrorCode_ThrottleLimitExceeded              ErrorCode = 31
+	ErrorCode_PerEndpointRequestThrottleExceeded ErrorCode = 36
+	ErrorCode_DestinyThrottledByGameServer       ErrorCode = 1672
+)
+
+// ErrMaintenance matches an APIError returned while the Bungie.net API is disabled for maintenance.
+// Use errors.Is(err, ErrMaintenance) to check for it.
+var ErrMaintenance = errors.New("the Bungie.net API is down for maintenance")
+
+// APIError is the error returned when a Bungie.net API call fails.
+// It is equivalent to service.APIError, without depending on the service package.
+type APIError struct {
+	ErrorCode ErrorCode
+	// ThrottleSeconds is how long to wait before making another request.
+	ThrottleSeconds time.Duration
+	ErrorStatus     string
+	Message         string
+	MessageData     map[string]string
 }
 
-func (e LocaleError) Error() string {
-	return fmt.Sprintf("%q is unsupported by the Bungie API", e.locale)
+func (err APIError) Error() string {
+	return fmt.Sprintf(`Bungie Error(%d): "%s: %s"`, err.ErrorCode, err.ErrorStatus, err.Message)
+}
+
+// Is reports whether target is ErrMaintenance and this error is because the Bungie.net API is disabled.
+func (err APIError) Is(target error) bool {
+	return target == ErrMaintenance && err.ErrorCode == ErrorCode_SystemDisabled
+}
+
+// UnmarshalJSON converts ThrottleSeconds from the seconds used by the Bungie.net API.
+func (err *APIError) UnmarshalJSON(data []byte) error {
+	var resp struct {
+		ErrorCode       ErrorCode
+		ThrottleSeconds int
+		ErrorStatus     string
+		Message         string
+		MessageData     map[string]string
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+	*err = APIError{
+		ErrorCode:       resp.ErrorCode,
+		ThrottleSeconds: time.Duration(resp.ThrottleSeconds) * time.Second,
+		ErrorStatus:     resp.ErrorStatus,
+		Message:         resp.Message,
+		MessageData:     resp.MessageData,
+	}
+	return nil
+}
+
+// throttled reports whether the request should be retried after ThrottleSeconds.
+func (err APIError) throttled() bool {
+	switch err.ErrorCode {
+	case ErrorCode_ThrottleLimitExceeded, ErrorCode_PerEndpointRequestThrottleExceeded, ErrorCode_DestinyThrottledByGameServer:
+		return true
+	}
+	return err.ThrottleSeconds > 0 && err.ErrorCode != ErrorCode_SystemDisabled
 }
 
 // Manifest is a representation of DestinyManifest, the external-facing contract
@@ -46,6 +87,9 @@ type Manifest struct {
 	// Mobile contracts for each language/locale.
 	mobileContracts map[language.Tag]string
 
+	// Aggregate contracts, containing every definition, for each language/locale.
+	aggregateContracts map[language.Tag]string
+
 	// Content paths.
 	cdn gearCDN
 
@@ -54,6 +98,11 @@ type Manifest struct {
 	clanBannerPath string
 
 	contractReader ContractReader
+
+	// store persists versions for pinning and rollback, if set.
+	store *VersionStore
+	// pinned is the version updates are refused past, if any.
+	pinned string
 }
 
 // NewManifest returns a populated Destiny 2 Manifest, similar to the
@@ -69,35 +118,85 @@ func NewManifest(reader ContractReader) (*Manifest, error) {
 type UpdateFunc func() error
 
 // Update updates the manifest to the newest version and, if necessary, runs updateFn.
+// If the update fails, such as during Bungie.net maintenance, the manifest is left unchanged and still usable.
+// Throttled requests are retried after the delay requested by Bungie.net, and temporary download failures
+// are retried with backoff, for at most updateAttempts requests in total.
+// A pinned manifest is never updated.
 func (m *Manifest) Update(updateFn UpdateFunc) error {
-	resp, err := http.Get("https://www.bungie.net/Platform/Destiny2/Manifest")
-	if err != nil {
-		return err
+	if m.pinned != "" {
+		return nil
+	}
+
+	backoff := downloadBackoff
+	var err error
+	for attempt := 0; attempt < updateAttempts; attempt++ {
+		var data json.RawMessage
+		data, err = getManifest()
+		if err == nil {
+			return m.parseManifest(data, func() error {
+				if err := m.recordVersion(data); err != nil {
+					return err
+				}
+				if updateFn != nil {
+					return updateFn()
+				}
+				return nil
+			})
+		}
+
+		var delay time.Duration
+		switch err := err.(type) {
+		case APIError:
+			if !err.throttled() {
+				return err
+			}
+			delay = err.ThrottleSeconds
+		case VerificationError:
+			if !err.temporary() {
+				return err
+			}
+			delay = backoff
+			backoff *= 2
+		default:
+			return err
+		}
+		if attempt+1 < updateAttempts {
+			sleep(delay)
+		}
 	}
-	defer resp.Body.Close()
+	return err
+}
+
+// updateAttempts is the number of times Update requests the manifest when throttled or failing temporarily.
+const updateAttempts = 3
+
+// sleep pauses between retried requests to Bungie.net.
+var sleep = time.Sleep
 
-	body, err := ioutil.ReadAll(resp.Body)
+// getManifest returns the DestinyManifest response from the Bungie.net API.
+func getManifest() (json.RawMessage, error) {
+	const path = "/Platform/Destiny2/Manifest"
+	// Update retries failed requests, so only one download is attempted here.
+	body, err := downloadOnce(path, verifyJSON(path))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// TODO(paranoiacblack): Handle error status and error code more traditionally.
-	var manifestResp struct {
-		Response        json.RawMessage
-		ErrorCode       int
-		ThrottleSeconds int
-		ErrorStatus     string
-		Message         string
-		MessageData     json.RawMessage
+	var apiErr APIError
+	if err := json.Unmarshal(body, &apiErr); err != nil {
+		return nil, err
+	}
+	if apiErr.ErrorCode != ErrorCode_Success {
+		return nil, apiErr
 	}
 
-	if err := json.Unmarshal(body, &manifestResp); err != nil {
-		return err
+	var manifestResp struct {
+		Response json.RawMessage
 	}
-	if err := m.parseManifest(manifestResp.Response, updateFn); err != nil {
-		return err
+	if err := json.Unmarshal(body, &manifestResp); err != nil {
+		return nil, err
 	}
-	return nil
+	return manifestResp.Response, nil
 }
 
 // Version returns the version string of this manifest.
@@ -118,16 +217,16 @@ func (m *Manifest) FulfillContract(definition Contract, opts ...FulfillmentOptio
 	path, ok := m.contracts[tag][definition.Name()]
 	if fulfillmentOpt.mobile {
 		path, ok = m.mobileContracts[tag]
+	} else if fulfillmentOpt.aggregate {
+		path, ok = m.aggregateContracts[tag]
 	}
 	if !ok {
 		return fmt.Errorf("%q is not a valid Destiny.Definitions name", definition.Name())
 	}
 
-	data, err := m.contractReader.ReadContract(definition, path, fulfillmentOpt.mobile)
-	if err != nil {
-		return err
-	}
-	return json.Unmarshal(data, definition)
+	key := ContractKey{Version: m.version, Locale: tag, Path: path, Mobile: fulfillmentOpt.mobile, Aggregate: fulfillmentOpt.aggregate && !fulfillmentOpt.mobile}
+	_, err := loadContractFrom(m.contractReader, definition, key)
+	return err
 }
 
 type fulfillmentOptions struct {
@@ -135,6 +234,8 @@ type fulfillmentOptions struct {
 	tag language.Tag
 	// if true, use the mobile manifest when fulfilling a contract
 	mobile bool
+	// if true, use the aggregate JSON manifest when fulfilling a contract
+	aggregate bool
 }
 
 // FulfillmentOption is an optional way to fulfill a given contract.
@@ -160,6 +261,15 @@ func UseMobileManifest(mobile bool) FulfillmentOption {
 	}
 }
 
+// UseAggregateManifest fulfills a contract using the aggregate JSON manifest, which contains every contract
+// for a language/locale in a single file. This should be used with an AggregateReader.
+func UseAggregateManifest(aggregate bool) FulfillmentOption {
+	return func(o *fulfillmentOptions) error {
+		o.aggregate = aggregate
+		return nil
+	}
+}
+
 // Paths where specific rendering information can be found.
 type gearCDN struct {
 	Geometry, Texture, PlateRegion, Gear, Shader string
@@ -176,10 +286,10 @@ type manifestResponse struct {
 	}
 	MobileWorldContentPaths json.RawMessage
 
-	// For the purpose of this API, we won't be using the aggregate
-	// JSON files that describe all world content.
-	JsonWorldContentPaths json.RawMessage `json:"-"`
-	// Instead, we use the individual components which define each entity.
+	// JsonWorldContentPaths are aggregate JSON files that describe all world content,
+	// only used when fulfilling contracts with UseAggregateManifest.
+	JsonWorldContentPaths json.RawMessage
+	// Otherwise, we use the individual components which define each entity.
 	JsonWorldComponentContentPaths json.RawMessage
 	MobileClanBannerDatabasePath   string
 	MobileGearCDN                  gearCDN
@@ -198,22 +308,29 @@ func (m *Manifest) parseManifest(data []byte, updateFn UpdateFunc) error {
 		return nil
 	}
 
-	if err := m.parseMobileContentPaths(resp.MobileWorldContentPaths); err != nil {
+	// Parse into a copy so the current manifest is untouched if any part of the response is invalid.
+	next := *m
+	if err := next.parseMobileContentPaths(resp.MobileWorldContentPaths); err != nil {
 		return err
 	}
 
-	if err := m.parseContractPaths(resp.JsonWorldComponentContentPaths); err != nil {
+	if err := next.parseContractPaths(resp.JsonWorldComponentContentPaths); err != nil {
 		return err
 	}
 
-	m.version = resp.Version
+	if err := next.parseAggregateContentPaths(resp.JsonWorldContentPaths); err != nil {
+		return err
+	}
+
+	next.version = resp.Version
 	gearDBs := []string{}
 	for _, db := range resp.MobileGearAssetDataBases {
 		gearDBs = append(gearDBs, db.Path)
 	}
-	m.gearAssetPath = gearDBs
-	m.clanBannerPath = resp.MobileClanBannerDatabasePath
-	m.cdn = resp.MobileGearCDN
+	next.gearAssetPath = gearDBs
+	next.clanBannerPath = resp.MobileClanBannerDatabasePath
+	next.cdn = resp.MobileGearCDN
+	*m = next
 
 	if updateFn != nil {
 		if err := updateFn(); err != nil {
@@ -241,6 +358,29 @@ func (m *Manifest) parseMobileContentPaths(data []byte) error {
 	return nil
 }
 
+// jsonWorldContentPaths are defined as {"locale": "path"}
+func (m *Manifest) parseAggregateContentPaths(data []byte) error {
+	m.aggregateContracts = map[language.Tag]string{}
+	if len(data) == 0 {
+		// The aggregate manifest is optional, so don't fail an update without it.
+		return nil
+	}
+
+	contentPaths := make(map[string]string)
+	if err := json.Unmarshal(data, &contentPaths); err != nil {
+		return err
+	}
+
+	for locale, path := range contentPaths {
+		tag := getSupportedTagForLocale(locale)
+		if tag == language.Und {
+			return LocaleError{locale}
+		}
+		m.aggregateContracts[tag] = path
+	}
+	return nil
+}
+
 // jsonWorldComponentsPath are defined as {"locale": {"definition": "path"}}
 func (m *Manifest) parseContractPaths(data []byte) error {
 	contractPaths := make(map[string]map[string]string)
@@ -262,17 +402,3 @@ func (m *Manifest) parseContractPaths(data []byte) error {
 	}
 	return nil
 }
-
-// Returns a language.Tag that matches Bungie-supported locales.
-func getSupportedTagForLocale(locale string) language.Tag {
-	tag, _, confidence := supportedLanguages.Match(language.Make(locale))
-	if confidence == language.No {
-		switch locale {
-		case "zh-cht":
-			tag = language.TraditionalChinese
-		case "zh-chs":
-			tag = language.SimplifiedChinese
-		}
-	}
-	return tag
-}
@@ -1,13 +1,17 @@
 package destiny2
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/text/language"
 )
 
 func TestUpdate(t *testing.T) {
@@ -44,6 +48,167 @@ type fulfillmentTests struct {
 	fn   func(t *testing.T)
 }
 
+const testManifestResponse = `{
+	"Response": {
+		"version": "v2",
+		"mobileWorldContentPaths": {"en": "/mobile/en.content"},
+		"jsonWorldComponentContentPaths": {"en": {"DestinyLoreDefinition": "/lore-v2.json"}}
+	},
+	"ErrorCode": 1,
+	"ThrottleSeconds": 0,
+	"ErrorStatus": "Success",
+	"Message": "Ok"
+}`
+
+func TestUpdate_Outage(t *testing.T) {
+	const maintenance = `{"ErrorCode": 5, "ThrottleSeconds": 0, "ErrorStatus": "SystemDisabled", "Message": "This system is temporarily disabled for maintenance."}`
+	serveBungie(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, maintenance)
+	}))
+
+	manifest := &Manifest{
+		version:   "v1",
+		contracts: map[language.Tag]map[string]string{language.English: {"DestinyLoreDefinition": "/lore-v1.json"}},
+	}
+	err := manifest.Update(nil)
+	if !errors.Is(err, ErrMaintenance) {
+		t.Fatalf("Update during maintenance: got error %v, want ErrMaintenance", err)
+	}
+	var apiErr APIError
+	if !errors.As(err, &apiErr) || apiErr.ErrorStatus != "SystemDisabled" {
+		t.Errorf("Update during maintenance: got error %#v, want APIError with ErrorStatus SystemDisabled", err)
+	}
+
+	if got := manifest.Version(); got != "v1" {
+		t.Errorf("Version() after failed update: got %q, want %q", got, "v1")
+	}
+	if got := manifest.contracts[language.English]["DestinyLoreDefinition"]; got != "/lore-v1.json" {
+		t.Errorf("contract path after failed update: got %q, want %q", got, "/lore-v1.json")
+	}
+}
+
+func TestUpdate_OutageStatus(t *testing.T) {
+	requests := 0
+	serveBungie(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"ErrorCode": 5, "ThrottleSeconds": 0, "ErrorStatus": "SystemDisabled", "Message": "Down for maintenance."}`)
+	}))
+
+	if err := new(Manifest).Update(nil); !errors.Is(err, ErrMaintenance) {
+		t.Fatalf("Update with a 503 during maintenance: got error %v, want ErrMaintenance", err)
+	}
+	if requests != 1 {
+		t.Errorf("Update during maintenance made %d requests, want 1", requests)
+	}
+}
+
+func TestUpdate_Throttled(t *testing.T) {
+	const throttled = `{"ErrorCode": 31, "ThrottleSeconds": 7, "ErrorStatus": "ThrottleLimitExceeded", "Message": "Slow down."}`
+	requests := 0
+	serveBungie(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			fmt.Fprint(w, throttled)
+			return
+		}
+		fmt.Fprint(w, testManifestResponse)
+	}))
+
+	var slept []time.Duration
+	oldSleep := sleep
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+	defer func() { sleep = oldSleep }()
+
+	manifest := new(Manifest)
+	if err := manifest.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]time.Duration{7 * time.Second}, slept); diff != "" {
+		t.Errorf("Update throttle delays: (-want +got)\n%s", diff)
+	}
+	if got := manifest.Version(); got != "v2" {
+		t.Errorf("Version() after throttled update: got %q, want %q", got, "v2")
+	}
+}
+
+func TestUpdate_Retries(t *testing.T) {
+	tests := []struct {
+		name      string
+		handler   http.HandlerFunc
+		wantSlept []time.Duration
+	}{
+		{
+			name: "always throttled",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"ErrorCode": 31, "ThrottleSeconds": 7, "ErrorStatus": "ThrottleLimitExceeded"}`)
+			},
+			wantSlept: []time.Duration{7 * time.Second, 7 * time.Second},
+		},
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			wantSlept: []time.Duration{0, 0},
+		},
+		{
+			name: "throttled status",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"ErrorCode": 36, "ThrottleSeconds": 3, "ErrorStatus": "PerEndpointRequestThrottleExceeded"}`)
+			},
+			wantSlept: []time.Duration{3 * time.Second, 3 * time.Second},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			serveBungie(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				test.handler(w, r)
+			}))
+
+			var slept []time.Duration
+			oldSleep := sleep
+			sleep = func(d time.Duration) { slept = append(slept, d) }
+			defer func() { sleep = oldSleep }()
+
+			if err := new(Manifest).Update(nil); err == nil {
+				t.Fatal("Update: got nil error")
+			}
+			if requests != updateAttempts {
+				t.Errorf("Update made %d requests, want %d", requests, updateAttempts)
+			}
+			if diff := cmp.Diff(test.wantSlept, slept); diff != "" {
+				t.Errorf("Update delays: (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate_InvalidResponse(t *testing.T) {
+	const invalid = `{"Response": {"version": "v2", "mobileWorldContentPaths": {"en": "/mobile/en.content"}, "jsonWorldComponentContentPaths": ["not", "a", "map"]}, "ErrorCode": 1}`
+	serveBungie(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, invalid)
+	}))
+
+	manifest := &Manifest{version: "v1", mobileContracts: map[language.Tag]string{language.English: "/mobile/v1.content"}}
+	if err := manifest.Update(nil); err == nil {
+		t.Fatal("Update with invalid response: got nil error")
+	}
+	if got := manifest.mobileContracts[language.English]; got != "/mobile/v1.content" {
+		t.Errorf("mobile contract path after failed update: got %q, want %q", got, "/mobile/v1.content")
+	}
+}
+
 func TestFulfillContract(t *testing.T) {
 	reader := NewTestReader()
 	manifest, err := NewManifest(reader)
@@ -285,3 +450,44 @@ func (r *sqliteReader) Close() error {
 	r.cachedContracts = nil
 	return nil
 }
+
+func TestContracts(t *testing.T) {
+	manifest := &Manifest{contracts: map[language.Tag]map[string]string{
+		language.English: {
+			"DestinyLoreDefinition":          "/lore.json",
+			"DestinyGenderDefinition":        "/genders.json",
+			"DestinyLoadoutIconDefinition":   "/icons.json",
+			"DestinyUntypedFutureDefinition": "/future.json",
+		},
+	}}
+	want := []string{"DestinyGenderDefinition", "DestinyLoreDefinition"}
+	if diff := cmp.Diff(want, manifest.Contracts()); diff != "" {
+		t.Errorf("Contracts() (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindEntity(t *testing.T) {
+	lore := &LoreDefinition{1: {Subtitle: "Ace", EntityMetadata: EntityMetadata{Hash: 1}}}
+	tests := []struct {
+		name     string
+		contract Contract
+		hash     uint32
+		want     interface{}
+		wantOK   bool
+	}{
+		{name: "found", contract: lore, hash: 1, want: (*lore)[1], wantOK: true},
+		{name: "missing", contract: lore, hash: 2},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := FindEntity(test.contract, test.hash)
+			if ok != test.wantOK {
+				t.Fatalf("FindEntity(%d) ok = %t, want %t", test.hash, ok, test.wantOK)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("FindEntity(%d) (-want +got):\n%s", test.hash, diff)
+			}
+		})
+	}
+}
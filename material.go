@@ -0,0 +1,75 @@
+package destiny2
+
+import "sort"
+
+// MaterialCost is the total amount of a material needed to insert a set of plugs.
+type MaterialCost struct {
+	// ItemHash is the hash of a related InventoryItemEntity.
+	ItemHash uint32
+	// Name is the localized name of the material, if it is a known item.
+	Name string
+	// Consumed is the amount of the material removed from the inventory, from requirements with DeleteOnAction.
+	Consumed int32
+	// Held is the largest amount of the material that must be held but isn't removed by any single insertion.
+	Held int32
+}
+
+// Required returns the amount of the material needed before inserting the plugs.
+func (c MaterialCost) Required() int32 {
+	return c.Consumed + c.Held
+}
+
+// MaterialCalculator totals the materials needed to insert plugs, such as masterworking a weapon tier by tier or
+// applying shaders to a set of armor.
+type MaterialCalculator struct {
+	// Items are the plugs being inserted and the materials they require.
+	Items InventoryItemDefinition
+	// MaterialRequirements are the requirements plugs refer to.
+	MaterialRequirements MaterialRequirementSetDefinition
+}
+
+// Cost returns the materials needed to insert every plug in plugHashes, in order, ordered by item hash.
+// A plug costs its InsertionMaterialRequirementHash and its EnabledMaterialRequirementHash, and is counted
+// once for each time it appears. Requirements omitted from the game's UI are ignored.
+func (c MaterialCalculator) Cost(plugHashes ...uint32) ([]MaterialCost, error) {
+	costs := map[uint32]*MaterialCost{}
+	for _, plugHash := range plugHashes {
+		plug, ok := c.Items[plugHash]
+		if !ok {
+			return nil, EntityError{InventoryItemDefinition{}.Name(), plugHash}
+		}
+
+		for _, setHash := range []uint32{plug.Plug.InsertionMaterialRequirementHash, plug.Plug.EnabledMaterialRequirementHash} {
+			if setHash == 0 {
+				continue
+			}
+			set, ok := c.MaterialRequirements[setHash]
+			if !ok {
+				return nil, EntityError{MaterialRequirementSetDefinition{}.Name(), setHash}
+			}
+
+			for _, material := range set.Materials {
+				if material.OmitFromRequirements || material.Count == 0 {
+					continue
+				}
+				cost, ok := costs[material.ItemHash]
+				if !ok {
+					cost = &MaterialCost{ItemHash: material.ItemHash, Name: c.Items[material.ItemHash].DisplayProperties.Name}
+					costs[material.ItemHash] = cost
+				}
+				if material.DeleteOnAction {
+					cost.Consumed += material.Count
+				} else if material.Count > cost.Held {
+					cost.Held = material.Count
+				}
+			}
+		}
+	}
+
+	totals := make([]MaterialCost, 0, len(costs))
+	for _, cost := range costs {
+		totals = append(totals, *cost)
+	}
+	sort.Slice(totals, func(i, j int) bool { return totals[i].ItemHash < totals[j].ItemHash })
+	return totals, nil
+}
@@ -0,0 +1,79 @@
+package destiny2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMaterialCalculator(t *testing.T) {
+	const (
+		glimmer, core, prism = 3159615086, 3853748946, 4257549984
+		shader, tier2, tier3 = 10, 12, 13
+	)
+	calc := MaterialCalculator{
+		Items: InventoryItemDefinition{
+			glimmer: {DisplayProperties: DisplayProperties{Name: "Glimmer"}},
+			core:    {DisplayProperties: DisplayProperties{Name: "Enhancement Core"}},
+			prism:   {DisplayProperties: DisplayProperties{Name: "Enhancement Prism"}},
+			shader:  {Plug: ItemPlug{InsertionMaterialRequirementHash: 1}},
+			tier2:   {Plug: ItemPlug{InsertionMaterialRequirementHash: 2}},
+			tier3:   {Plug: ItemPlug{InsertionMaterialRequirementHash: 3, EnabledMaterialRequirementHash: 4}},
+			20:      {Plug: ItemPlug{InsertionMaterialRequirementHash: 99}},
+		},
+		MaterialRequirements: MaterialRequirementSetDefinition{
+			1: {Materials: []MaterialRequirement{{ItemHash: glimmer, Count: 500, DeleteOnAction: true}}},
+			2: {Materials: []MaterialRequirement{{ItemHash: glimmer, Count: 2000, DeleteOnAction: true}, {ItemHash: core, Count: 1, DeleteOnAction: true}}},
+			3: {Materials: []MaterialRequirement{
+				{ItemHash: glimmer, Count: 3000, DeleteOnAction: true},
+				{ItemHash: core, Count: 2, DeleteOnAction: true},
+				{ItemHash: 1, Count: 5, DeleteOnAction: true, OmitFromRequirements: true},
+			}},
+			// Enabling a plug only requires holding its materials.
+			4: {Materials: []MaterialRequirement{{ItemHash: prism, Count: 1}, {ItemHash: core, Count: 4}}},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		plugs []uint32
+		want  []MaterialCost
+	}{
+		{name: "nothing", want: []MaterialCost{}},
+		{
+			name:  "shaders",
+			plugs: []uint32{shader, shader, shader},
+			want:  []MaterialCost{{ItemHash: glimmer, Name: "Glimmer", Consumed: 1500}},
+		},
+		{
+			name:  "masterwork",
+			plugs: []uint32{tier2, tier3},
+			want: []MaterialCost{
+				{ItemHash: glimmer, Name: "Glimmer", Consumed: 5000},
+				{ItemHash: core, Name: "Enhancement Core", Consumed: 3, Held: 4},
+				{ItemHash: prism, Name: "Enhancement Prism", Held: 1},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := calc.Cost(test.plugs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Cost() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if got := (MaterialCost{Consumed: 3, Held: 4}).Required(); got != 7 {
+		t.Errorf("Required(): got %d, want 7", got)
+	}
+	for _, hash := range []uint32{20, 30} {
+		if _, err := calc.Cost(hash); !errors.As(err, &EntityError{}) {
+			t.Errorf("Cost(%d): got error %v, want EntityError", hash, err)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package destiny2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The readers in this file wrap other ContractReaders so they can be combined, such as
+// ReadOnlyReader(CachingReader(FallbackReader(disk, api), store)).
+// A BinaryCacheReader within a FallbackReader or InstrumentedReader still loads its cached contracts directly,
+// but a CachingReader stores marshalled contracts, so a BinaryCacheReader within one only reads from its Source.
+
+// ErrNetworkDisabled is returned when a ReadOnlyReader refuses to read a contract over the network.
+var ErrNetworkDisabled = errors.New("network access is disabled for this reader")
+
+// networkReader is implemented by ContractReaders that read contracts over the network.
+type networkReader interface {
+	readsNetwork() bool
+}
+
+// cacheReporter is implemented by the readers in this package, so an InstrumentedReader can report whether a read
+// was served from a cache anywhere within the readers it wraps, and so a ContractLoader within them is still used.
+type cacheReporter interface {
+	// readContractCached is ReadContract, also reporting whether the contract was read from a cache.
+	readContractCached(contract Contract, path string, useMobile bool) ([]byte, bool, error)
+	// loadContractCached populates contract with the contract identified by key, also reporting whether it
+	// was read from a cache.
+	loadContractCached(contract Contract, key ContractKey) (bool, error)
+}
+
+// readContractFrom reads a contract from reader, reporting whether it was read from a cache within reader.
+func readContractFrom(reader ContractReader, contract Contract, path string, useMobile bool) ([]byte, bool, error) {
+	if r, ok := reader.(cacheReporter); ok {
+		return r.readContractCached(contract, path, useMobile)
+	}
+	data, err := reader.ReadContract(contract, path, useMobile)
+	return data, false, err
+}
+
+// loadContractFrom populates contract from reader, preferring LoadContract if reader is a ContractLoader,
+// and reports whether it was read from a cache within reader.
+func loadContractFrom(reader ContractReader, contract Contract, key ContractKey) (bool, error) {
+	if r, ok := reader.(cacheReporter); ok {
+		return r.loadContractCached(contract, key)
+	}
+	if loader, ok := reader.(ContractLoader); ok {
+		return false, loader.LoadContract(contract, key)
+	}
+	data, err := reader.ReadContract(contract, key.Path, key.Mobile)
+	if err != nil {
+		return false, err
+	}
+	return false, json.Unmarshal(data, contract)
+}
+
+// FallbackError is returned by a FallbackReader when every reader fails.
+type FallbackError struct {
+	// Errs are the errors from each reader, in order.
+	Errs []error
+}
+
+func (e FallbackError) Error() string {
+	msgs := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("all readers failed: %s", strings.Join(msgs, "; "))
+}
+
+type fallbackReader struct {
+	readers []ContractReader
+}
+
+// FallbackReader returns a ContractReader that tries each reader in order and returns the first successful read.
+func FallbackReader(readers ...ContractReader) ContractReader {
+	return &fallbackReader{readers: readers}
+}
+
+func (r *fallbackReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	data, _, err := r.readContractCached(contract, path, useMobile)
+	return data, err
+}
+
+func (r *fallbackReader) readContractCached(contract Contract, path string, useMobile bool) ([]byte, bool, error) {
+	var errs []error
+	for _, reader := range r.readers {
+		data, hit, err := readContractFrom(reader, contract, path, useMobile)
+		if err == nil {
+			return data, hit, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, false, FallbackError{errs}
+}
+
+func (r *fallbackReader) loadContractCached(contract Contract, key ContractKey) (bool, error) {
+	var errs []error
+	for _, reader := range r.readers {
+		hit, err := loadContractFrom(reader, contract, key)
+		if err == nil {
+			return hit, nil
+		}
+		errs = append(errs, err)
+	}
+	return false, FallbackError{errs}
+}
+
+// Close closes every reader and returns the first error.
+func (r *fallbackReader) Close() error {
+	var first error
+	for _, reader := range r.readers {
+		if err := reader.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// ContractStore stores marshalled contracts for a CachingReader.
+type ContractStore interface {
+	// Get returns the contract stored with key, if any.
+	Get(key string) ([]byte, bool)
+	// Put stores a contract with key.
+	Put(key string, data []byte) error
+}
+
+// MemoryStore is a ContractStore that keeps contracts in memory.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	contracts map[string][]byte
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{contracts: map[string][]byte{}}
+}
+
+// Get returns the contract stored with key, if any.
+func (s *MemoryStore) Get(key string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.contracts[key]
+	return data, ok
+}
+
+// Put stores a contract with key, replacing any contract already stored with it.
+func (s *MemoryStore) Put(key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.contracts[key] = data
+	return nil
+}
+
+// DirStore is a ContractStore that keeps each contract in a file within a directory.
+type DirStore string
+
+func (s DirStore) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(string(s), hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the contract stored in the file for key, if it exists and can be read.
+func (s DirStore) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(s.file(key))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Put writes the contract to the file for key, so Get never sees a partial contract.
+func (s DirStore) Put(key string, data []byte) error {
+	return writeFileAtomic(s.file(key), writeBytes(data))
+}
+
+type cachingReader struct {
+	reader ContractReader
+	store  ContractStore
+}
+
+// CachingReader returns a ContractReader that reads contracts from store, reading and storing them from reader when missing.
+// Contracts are stored by their name, path and whether they are from the mobile manifest.
+func CachingReader(reader ContractReader, store ContractStore) ContractReader {
+	return &cachingReader{reader: reader, store: store}
+}
+
+func (r *cachingReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	data, _, err := r.readContractCached(contract, path, useMobile)
+	return data, err
+}
+
+// readContractCached reports a hit if the contract was found in the store or in a cache within the reader.
+func (r *cachingReader) readContractCached(contract Contract, path string, useMobile bool) ([]byte, bool, error) {
+	key := fmt.Sprintf("%s:%s:%t", contract.Name(), path, useMobile)
+	if data, ok := r.store.Get(key); ok {
+		return data, true, nil
+	}
+
+	data, hit, err := readContractFrom(r.reader, contract, path, useMobile)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := r.store.Put(key, data); err != nil {
+		return nil, false, err
+	}
+	return data, hit, nil
+}
+
+func (r *cachingReader) loadContractCached(contract Contract, key ContractKey) (bool, error) {
+	data, hit, err := r.readContractCached(contract, key.Path, key.Mobile)
+	if err != nil {
+		return false, err
+	}
+	return hit, json.Unmarshal(data, contract)
+}
+
+func (r *cachingReader) Close() error {
+	return r.reader.Close()
+}
+
+// ReadEvent describes a single contract read by an InstrumentedReader.
+type ReadEvent struct {
+	// Contract is the name of the contract that was read.
+	Contract string
+	Path     string
+	Mobile   bool
+	// Bytes is the size of the marshalled contract, which is 0 if it was loaded by a ContractLoader such as
+	// a BinaryCacheReader.
+	Bytes int
+	// Latency is how long the read took.
+	Latency time.Duration
+	// CacheHit is true if the contract was read from a CachingReader's store or a BinaryCacheReader's directory
+	// anywhere within the instrumented reader.
+	CacheHit bool
+	// Err is the error returned by the read, if any.
+	Err error
+}
+
+// ReaderHooks are callbacks run by an InstrumentedReader.
+type ReaderHooks struct {
+	// OnRead is called after every contract read.
+	OnRead func(ReadEvent)
+}
+
+type instrumentedReader struct {
+	reader ContractReader
+	hooks  ReaderHooks
+}
+
+// InstrumentedReader returns a ContractReader that reports the size, latency and cache hits of every read to hooks.
+// Cache hits are reported for the readers in this package however they are combined within reader; readers from
+// other packages are always reported as misses.
+func InstrumentedReader(reader ContractReader, hooks ReaderHooks) ContractReader {
+	return &instrumentedReader{reader: reader, hooks: hooks}
+}
+
+func (r *instrumentedReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	data, _, err := r.readContractCached(contract, path, useMobile)
+	return data, err
+}
+
+func (r *instrumentedReader) readContractCached(contract Contract, path string, useMobile bool) ([]byte, bool, error) {
+	start := time.Now()
+	data, hit, err := readContractFrom(r.reader, contract, path, useMobile)
+	r.report(ReadEvent{Contract: contract.Name(), Path: path, Mobile: useMobile, Bytes: len(data), CacheHit: hit, Err: err}, start)
+	return data, hit, err
+}
+
+func (r *instrumentedReader) loadContractCached(contract Contract, key ContractKey) (bool, error) {
+	start := time.Now()
+	hit, err := loadContractFrom(r.reader, contract, key)
+	r.report(ReadEvent{Contract: contract.Name(), Path: key.Path, Mobile: key.Mobile, CacheHit: hit, Err: err}, start)
+	return hit, err
+}
+
+// report sends event to the OnRead hook with the latency of a read that began at start.
+func (r *instrumentedReader) report(event ReadEvent, start time.Time) {
+	if r.hooks.OnRead != nil {
+		event.Latency = time.Since(start)
+		r.hooks.OnRead(event)
+	}
+}
+
+func (r *instrumentedReader) Close() error {
+	return r.reader.Close()
+}
+
+type offlineReader struct {
+	reader ContractReader
+}
+
+func (r offlineReader) ReadContract(contract Contract, path string, useMobile bool) ([]byte, error) {
+	return nil, ErrNetworkDisabled
+}
+
+func (r offlineReader) Close() error {
+	return r.reader.Close()
+}
+
+// ReadOnlyReader returns a ContractReader that doesn't access the network through this package's readers.
+// Readers within reader that would read from the network, such as BungieAPIReader, fail with ErrNetworkDisabled;
+// so a FallbackReader skips them and a CachingReader only serves contracts already in its store.
+// Readers from other packages are returned unchanged, since they can't be told apart from local readers,
+// so a custom reader that uses the network must be left out of reader to stay offline.
+func ReadOnlyReader(reader ContractReader) ContractReader {
+	switch r := reader.(type) {
+	case networkReader:
+		if r.readsNetwork() {
+			return offlineReader{reader}
+		}
+		return reader
+	case *fallbackReader:
+		readers := make([]ContractReader, len(r.readers))
+		for i, reader := range r.readers {
+			readers[i] = ReadOnlyReader(reader)
+		}
+		return &fallbackReader{readers: readers}
+	case *cachingReader:
+		return &cachingReader{reader: ReadOnlyReader(r.reader), store: r.store}
+	case *instrumentedReader:
+		return &instrumentedReader{reader: ReadOnlyReader(r.reader), hooks: r.hooks}
+	case *BinaryCacheReader:
+		if r.Source == nil {
+			return r
+		}
+		return &BinaryCacheReader{Dir: r.Dir, Source: ReadOnlyReader(r.Source)}
+	}
+	return reader
+}
@@ -0,0 +1,160 @@
+package destiny2
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// fakeNetworkReader stands in for a reader that reads from the network, such as BungieAPIReader.
+type fakeNetworkReader struct {
+	pathReader
+}
+
+func (fakeNetworkReader) readsNetwork() bool {
+	return true
+}
+
+func TestFallbackReader(t *testing.T) {
+	disk := pathReader{"/lore": "disk"}
+	api := pathReader{"/lore": "api", "/genders": "api"}
+	reader := FallbackReader(disk, api)
+
+	tests := []struct {
+		path, want string
+	}{
+		{"/lore", "disk"},
+		{"/genders", "api"},
+	}
+	for _, test := range tests {
+		data, err := reader.ReadContract(&LoreDefinition{}, test.path, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != test.want {
+			t.Errorf("ReadContract(%q): got %q, want %q", test.path, data, test.want)
+		}
+	}
+
+	var fallbackErr FallbackError
+	if _, err := reader.ReadContract(&LoreDefinition{}, "/missing", false); !errors.As(err, &fallbackErr) || len(fallbackErr.Errs) != 2 {
+		t.Errorf("ReadContract of a missing contract: got %v, want FallbackError with 2 errors", err)
+	}
+}
+
+func TestCachingReader(t *testing.T) {
+	for name, store := range map[string]ContractStore{
+		"MemoryStore": NewMemoryStore(),
+		"DirStore":    DirStore(t.TempDir()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			source := &countingReader{ContractReader: pathReader{"/lore": "lore"}}
+
+			var events []ReadEvent
+			reader := InstrumentedReader(CachingReader(source, store), ReaderHooks{
+				OnRead: func(event ReadEvent) { events = append(events, event) },
+			})
+
+			for i := 0; i < 2; i++ {
+				data, err := reader.ReadContract(&LoreDefinition{}, "/lore", false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(data) != "lore" {
+					t.Errorf("ReadContract: got %q, want %q", data, "lore")
+				}
+			}
+
+			if source.reads != 1 {
+				t.Errorf("source should only be read once: got %d reads", source.reads)
+			}
+			if len(events) != 2 {
+				t.Fatalf("got %d read events, want 2", len(events))
+			}
+			if events[0].CacheHit || !events[1].CacheHit {
+				t.Errorf("only the second read should be a cache hit: got %v, %v", events[0].CacheHit, events[1].CacheHit)
+			}
+			if events[1].Bytes != len("lore") || events[1].Contract != (LoreDefinition{}).Name() {
+				t.Errorf("unexpected read event: %+v", events[1])
+			}
+		})
+	}
+}
+
+func TestReadOnlyReader(t *testing.T) {
+	disk := pathReader{"/lore": "disk"}
+	api := fakeNetworkReader{pathReader{"/lore": "api", "/genders": "api"}}
+	store := NewMemoryStore()
+	reader := ReadOnlyReader(CachingReader(FallbackReader(disk, api), store))
+
+	data, err := reader.ReadContract(&LoreDefinition{}, "/lore", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "disk" {
+		t.Errorf("ReadContract: got %q, want %q", data, "disk")
+	}
+
+	_, err = reader.ReadContract(&GenderDefinition{}, "/genders", false)
+	var fallbackErr FallbackError
+	if !errors.As(err, &fallbackErr) || !errors.Is(fallbackErr.Errs[1], ErrNetworkDisabled) {
+		t.Errorf("ReadContract from the network: got %v, want ErrNetworkDisabled from the API reader", err)
+	}
+
+	if _, err := ReadOnlyReader(&BungieAPIReader{}).ReadContract(&LoreDefinition{}, "/lore", false); !errors.Is(err, ErrNetworkDisabled) {
+		t.Errorf("BungieAPIReader should be disabled: got %v", err)
+	}
+}
+
+func TestInstrumentedReaderNested(t *testing.T) {
+	lore := LoreDefinition{}.Name()
+	tests := []struct {
+		name   string
+		reader func(source ContractReader) ContractReader
+	}{
+		{"FallbackReader", func(source ContractReader) ContractReader {
+			return FallbackReader(pathReader{}, CachingReader(source, NewMemoryStore()))
+		}},
+		{"BinaryCacheReader", func(source ContractReader) ContractReader {
+			return &BinaryCacheReader{Dir: t.TempDir(), Source: source}
+		}},
+		{"FallbackReader with BinaryCacheReader", func(source ContractReader) ContractReader {
+			return FallbackReader(&BinaryCacheReader{Dir: t.TempDir(), Source: source}, pathReader{})
+		}},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			source := &countingReader{ContractReader: pathReader{"/lore": `{"1": {"hash": 1, "subtitle": "Ace"}}`}}
+			var events []ReadEvent
+			manifest := &Manifest{
+				version:   "1.0",
+				contracts: map[language.Tag]map[string]string{language.English: {lore: "/lore"}},
+				contractReader: InstrumentedReader(test.reader(source), ReaderHooks{
+					OnRead: func(event ReadEvent) { events = append(events, event) },
+				}),
+			}
+
+			for i := 0; i < 2; i++ {
+				var got LoreDefinition
+				if err := manifest.FulfillContract(&got); err != nil {
+					t.Fatal(err)
+				}
+				if got[1].Subtitle != "Ace" {
+					t.Errorf("FulfillContract: got subtitle %q, want %q", got[1].Subtitle, "Ace")
+				}
+			}
+
+			if source.reads != 1 {
+				t.Errorf("source should only be read once: got %d reads", source.reads)
+			}
+			if len(events) != 2 {
+				t.Fatalf("got %d read events, want 2", len(events))
+			}
+			if events[0].CacheHit || !events[1].CacheHit {
+				t.Errorf("only the second read should be a cache hit: got %v, %v", events[0].CacheHit, events[1].CacheHit)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package destiny2
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// maxPips is the most pips an objective is shown with, so overcompleted objectives have a bounded length.
+const maxPips = 100
+
+// dateTimeLayouts are the layouts UnlockValue_DateTime values are shown with, by language.
+// Languages without a layout use "2006-01-02 15:04".
+var dateTimeLayouts = map[string]string{
+	"de": "02.01.2006 15:04",
+	"es": "02/01/2006 15:04",
+	"fr": "02/01/2006 15:04",
+	"it": "02/01/2006 15:04",
+	"ja": "2006/01/02 15:04",
+	"ko": "2006. 01. 02. 15:04",
+	"pl": "02.01.2006 15:04",
+	"pt": "02/01/2006 15:04",
+	"ru": "02.01.2006 15:04",
+	"zh": "2006/01/02 15:04",
+}
+
+// ObjectiveFormatter formats the progress of objectives the way the game displays them.
+type ObjectiveFormatter struct {
+	// Locale is the language/locale numbers and dates are formatted for, such as language.German for "1.000"
+	// and "13.09.2020 12:26".
+	// If Locale is language.Und, English is used.
+	Locale language.Tag
+	// Location is the time zone UnlockValue_DateTime values are shown in. If Location is nil, UTC is used.
+	Location *time.Location
+}
+
+// IsComplete reports whether progress completes an objective. Objectives counting downward are complete once
+// progress falls to their CompletionValue.
+func IsComplete(objective ObjectiveEntity, progress int32) bool {
+	if objective.IsCountingDownward {
+		return progress <= objective.CompletionValue
+	}
+	return progress >= objective.CompletionValue
+}
+
+// ObjectiveStyle returns the style progress on an objective is shown with: CompletedValueStyle or
+// InProgressValueStyle, or ValueStyle if that is UnlockValue_Automatic. A complete objective that doesn't
+// ShowValueOnComplete is shown as a checkbox.
+func ObjectiveStyle(objective ObjectiveEntity, progress int32) UnlockValueUIStyle {
+	style := objective.InProgressValueStyle
+	if IsComplete(objective, progress) {
+		if !objective.ShowValueOnComplete {
+			return UnlockValue_Checkbox
+		}
+		style = objective.CompletedValueStyle
+	}
+	if style == UnlockValue_Automatic {
+		style = objective.ValueStyle
+	}
+	return style
+}
+
+// Format returns progress on objective as the game displays it, which is "" for UnlockValue_Hidden.
+// Progress is clamped to CompletionValue unless the objective AllowOvercompletion, and to 0 unless it
+// AllowNegativeValue. Fractions, percentages and pips are relative to CompletionValue; objectives counting
+// downward have no starting value to be relative to, so their fractions and percentages show the value alone as
+// a plain number.
+// At most 100 pips are shown.
+func (f ObjectiveFormatter) Format(objective ObjectiveEntity, progress int32) string {
+	style := ObjectiveStyle(objective, progress)
+	complete := IsComplete(objective, progress)
+	progress = clampObjective(objective, progress)
+	completion := objective.CompletionValue
+	relative := !objective.IsCountingDownward && completion != 0
+
+	locale := f.Locale
+	if locale == language.Und {
+		locale = language.English
+	}
+	p := message.NewPrinter(locale)
+
+	switch style {
+	case UnlockValue_Fraction:
+		if !relative {
+			return p.Sprintf("%d", progress)
+		}
+		return p.Sprintf("%d/%d", progress, completion)
+	case UnlockValue_Checkbox:
+		if complete {
+			return "☑"
+		}
+		return "☐"
+	case UnlockValue_Percentage:
+		if !relative {
+			// The value isn't a percentage of anything, so it is shown as a plain number.
+			return p.Sprintf("%d", progress)
+		}
+		// The game rounds down so an objective isn't shown as 100% before it is complete.
+		percent := int64(progress) * 100 / int64(completion)
+		return p.Sprint(number.Percent(float64(percent)/100, number.MaxFractionDigits(0)))
+	case UnlockValue_ExplicitPercentage:
+		return p.Sprint(number.Percent(float64(progress)/100, number.MaxFractionDigits(0)))
+	case UnlockValue_FractionFloat:
+		value := float64(progress)
+		if relative {
+			value /= float64(completion)
+		}
+		return p.Sprint(number.Decimal(value, number.MinFractionDigits(2), number.MaxFractionDigits(2)))
+	case UnlockValue_RawFloat:
+		// Raw floats are stored as hundredths.
+		return p.Sprint(number.Decimal(float64(progress)/100, number.MinFractionDigits(2), number.MaxFractionDigits(2)))
+	case UnlockValue_DateTime:
+		location := f.Location
+		if location == nil {
+			location = time.UTC
+		}
+		layout := "2006-01-02 15:04"
+		if base, _ := locale.Base(); dateTimeLayouts[base.String()] != "" {
+			layout = dateTimeLayouts[base.String()]
+		}
+		return time.Unix(int64(progress), 0).In(location).Format(layout)
+	case UnlockValue_TimeDuration:
+		return formatDuration(p, progress)
+	case UnlockValue_Hidden:
+		return ""
+	case UnlockValue_Multiplier:
+		return p.Sprintf("%dx", progress)
+	case UnlockValue_GreenPips, UnlockValue_RedPips:
+		// Objectives that AllowNegativeValue may have negative progress or completion, which show no pips.
+		filled, total := int(progress), int(completion)
+		if filled < 0 {
+			filled = 0
+		}
+		if total < filled {
+			total = filled
+		}
+		if total > maxPips {
+			total = maxPips
+		}
+		if filled > total {
+			filled = total
+		}
+		return strings.Repeat("●", filled) + strings.Repeat("○", total-filled)
+	}
+	return p.Sprintf("%d", progress)
+}
+
+// clampObjective returns progress limited to the values an objective can show.
+func clampObjective(objective ObjectiveEntity, progress int32) int32 {
+	if !objective.AllowOvercompletion {
+		if objective.IsCountingDownward && progress < objective.CompletionValue {
+			progress = objective.CompletionValue
+		} else if !objective.IsCountingDownward && progress > objective.CompletionValue {
+			progress = objective.CompletionValue
+		}
+	}
+	if !objective.AllowNegativeValue && progress < 0 {
+		progress = 0
+	}
+	return progress
+}
+
+// formatDuration formats seconds as m:ss, or h:mm:ss if there are hours, with numbers formatted by p.
+func formatDuration(p *message.Printer, seconds int32) string {
+	sign := ""
+	total := int64(seconds)
+	if total < 0 {
+		sign, total = "-", -total
+	}
+	h, m, s := total/3600, total/60%60, total%60
+	if h > 0 {
+		return sign + p.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return sign + p.Sprintf("%d:%02d", m, s)
+}
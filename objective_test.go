@@ -0,0 +1,68 @@
+package destiny2
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestObjectiveFormatter(t *testing.T) {
+	kills := ObjectiveEntity{CompletionValue: 1000, InProgressValueStyle: UnlockValue_Fraction, CompletedValueStyle: UnlockValue_Fraction, ShowValueOnComplete: true}
+	overcomplete := kills
+	overcomplete.AllowOvercompletion = true
+	hidden := kills
+	hidden.ShowValueOnComplete = false
+	percent := ObjectiveEntity{CompletionValue: 300, ValueStyle: UnlockValue_Percentage, ShowValueOnComplete: true}
+	countdown := ObjectiveEntity{CompletionValue: 0, IsCountingDownward: true, ValueStyle: UnlockValue_TimeDuration, ShowValueOnComplete: true}
+	pips := ObjectiveEntity{CompletionValue: 7, ValueStyle: UnlockValue_GreenPips}
+	negativePips := ObjectiveEntity{CompletionValue: -3, ValueStyle: UnlockValue_RedPips, AllowNegativeValue: true, AllowOvercompletion: true, ShowValueOnComplete: true}
+	overcompletePips := ObjectiveEntity{CompletionValue: 7, ValueStyle: UnlockValue_GreenPips, AllowOvercompletion: true, ShowValueOnComplete: true}
+	datetime := ObjectiveEntity{CompletionValue: 1, ValueStyle: UnlockValue_DateTime, AllowOvercompletion: true, ShowValueOnComplete: true}
+
+	tests := []struct {
+		name      string
+		locale    language.Tag
+		objective ObjectiveEntity
+		progress  int32
+		want      string
+	}{
+		{name: "fraction", objective: kills, progress: 250, want: "250/1,000"},
+		{name: "fraction german", locale: language.German, objective: kills, progress: 250, want: "250/1.000"},
+		{name: "fraction clamped", objective: kills, progress: 1500, want: "1,000/1,000"},
+		{name: "fraction overcompleted", objective: overcomplete, progress: 1500, want: "1,500/1,000"},
+		{name: "negative clamped", objective: kills, progress: -5, want: "0/1,000"},
+		{name: "complete checkbox", objective: hidden, progress: 1000, want: "☑"},
+		{name: "incomplete not checkbox", objective: hidden, progress: 10, want: "10/1,000"},
+		{name: "percentage rounds down", objective: percent, progress: 299, want: "99%"},
+		{name: "percentage french", locale: language.French, objective: percent, progress: 150, want: "50 %"},
+		{name: "countdown", objective: countdown, progress: 3725, want: "1:02:05"},
+		{name: "countdown complete", objective: countdown, progress: -3, want: "0:00"},
+		{name: "countdown long", objective: countdown, progress: 3600 * 1234, want: "1,234:00:00"},
+		{name: "countdown percentage", objective: ObjectiveEntity{IsCountingDownward: true, ValueStyle: UnlockValue_Percentage, ShowValueOnComplete: true}, progress: 3725, want: "3,725"},
+		{name: "countdown long german", locale: language.German, objective: countdown, progress: 3600 * 1234, want: "1.234:00:00"},
+		{name: "pips", objective: pips, progress: 3, want: "●●●○○○○"},
+		{name: "pips negative", objective: negativePips, progress: -5, want: ""},
+		{name: "pips negative completion", objective: negativePips, progress: 2, want: "●●"},
+		{name: "pips capped", objective: overcompletePips, progress: 1 << 30, want: strings.Repeat("●", maxPips)},
+		{name: "explicit percentage", objective: ObjectiveEntity{CompletionValue: 1, ValueStyle: UnlockValue_ExplicitPercentage}, progress: 0, want: "0%"},
+		{name: "raw float", objective: ObjectiveEntity{CompletionValue: 1000, ValueStyle: UnlockValue_RawFloat}, progress: 125, want: "1.25"},
+		{name: "fraction float", objective: ObjectiveEntity{CompletionValue: 8, ValueStyle: UnlockValue_FractionFloat}, progress: 2, want: "0.25"},
+		{name: "multiplier", objective: ObjectiveEntity{CompletionValue: 10, ValueStyle: UnlockValue_Multiplier}, progress: 2, want: "2x"},
+		{name: "datetime", objective: datetime, progress: 1600000000, want: "2020-09-13 12:26"},
+		{name: "datetime german", locale: language.German, objective: datetime, progress: 1600000000, want: "13.09.2020 12:26"},
+		{name: "datetime brazilian portuguese", locale: language.BrazilianPortuguese, objective: datetime, progress: 1600000000, want: "13/09/2020 12:26"},
+		{name: "hidden", objective: ObjectiveEntity{CompletionValue: 10, ValueStyle: UnlockValue_Hidden}, progress: 2, want: ""},
+		{name: "automatic", objective: ObjectiveEntity{CompletionValue: 100000}, progress: 12345, want: "12,345"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			f := ObjectiveFormatter{Locale: test.locale, Location: time.UTC}
+			if got := f.Format(test.objective, test.progress); got != test.want {
+				t.Errorf("Format(%d) = %q, want %q", test.progress, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package destiny2
+
+import (
+	"fmt"
+	"sort"
+)
+
+// InsertRejection classifies why a plug can't be inserted into a socket.
+type InsertRejection int
+
+const (
+	// RejectedSocket means the item has no socket at the given index, or the socket's type is unknown.
+	RejectedSocket InsertRejection = iota
+	// RejectedCategory means the plug's category isn't in the socket type's PlugWhitelist.
+	RejectedCategory
+	// RejectedClass means the plug is restricted to a different class than the item.
+	RejectedClass
+	// RejectedEnergyType means the plug costs a different type of energy than the item has.
+	RejectedEnergyType
+	// RejectedEnergyCapacity means the item doesn't have enough unused energy for the plug.
+	RejectedEnergyCapacity
+)
+
+func (r InsertRejection) String() string {
+	switch r {
+	case RejectedSocket:
+		return "no such socket"
+	case RejectedCategory:
+		return "plug category not allowed"
+	case RejectedClass:
+		return "class restricted"
+	case RejectedEnergyType:
+		return "wrong energy type"
+	case RejectedEnergyCapacity:
+		return "not enough energy"
+	}
+	return "unknown rejection"
+}
+
+// InsertError represents a plug that can't be inserted into a socket.
+type InsertError struct {
+	// PlugHash is the hash of the rejected plug's InventoryItemEntity.
+	PlugHash uint32
+	// SocketIndex is the index of the socket in InventoryItemEntity.Sockets.SocketEntries.
+	SocketIndex int
+	// Reason classifies why the plug was rejected.
+	Reason InsertRejection
+	// Detail describes why the plug was rejected.
+	Detail string
+}
+
+func (e InsertError) Error() string {
+	return fmt.Sprintf("plug %d can't be inserted into socket %d: %s: %s", e.PlugHash, e.SocketIndex, e.Reason, e.Detail)
+}
+
+// PlugChecker decides which plugs can be inserted into the sockets of items.
+// Only restrictions described by the manifest are checked; InsertionRules depend on the player's progress and
+// are left to the game.
+type PlugChecker struct {
+	// Items are the items plugs and initial plugs refer to.
+	Items InventoryItemDefinition
+	// SocketTypes are the socket types sockets refer to.
+	SocketTypes SocketTypeDefinition
+	// EnergyTypes are the energy types plugs refer to by EnergyTypeHash. Plugs with an unknown energy type
+	// use the energy in their EnergyCost and EnergyCapacity.
+	EnergyTypes EnergyTypeDefinition
+}
+
+// ItemEnergy is the energy of an item with plugs inserted.
+type ItemEnergy struct {
+	// Type is the type of energy the item has, which is EnergyType_Any if it has no energy.
+	Type EnergyType
+	// Capacity is the energy capacity provided by the item's plugs.
+	Capacity int32
+	// Used is the energy cost of the item's plugs.
+	Used int32
+}
+
+// Unused returns the energy left for more plugs.
+func (e ItemEnergy) Unused() int32 {
+	return e.Capacity - e.Used
+}
+
+// plugCost returns the energy type and cost of a plug: its investment in the cost stat of its energy type,
+// or its EnergyCost if it has no such investment. The type is EnergyType_Any if the plug fits any energy.
+func (d EnergyTypeDefinition) plugCost(plug InventoryItemEntity) (EnergyType, int32) {
+	cost := plug.Plug.EnergyCost
+	energyType, ok := d[cost.EnergyTypeHash]
+	if !ok {
+		return cost.EnergyType, cost.EnergyCost
+	}
+	for _, stat := range plug.InvestmentStats {
+		if stat.StatTypeHash == energyType.CostStatHash {
+			return energyType.EnumValue, stat.Value
+		}
+	}
+	return energyType.EnumValue, cost.EnergyCost
+}
+
+// plugCapacity returns the energy type and capacity a plug provides: its investment in the capacity stat of its
+// energy type, or its CapacityValue if it has no such investment.
+func (d EnergyTypeDefinition) plugCapacity(plug InventoryItemEntity) (EnergyType, int32) {
+	capacity := plug.Plug.EnergyCapacity
+	energyType, ok := d[capacity.EnergyTypeHash]
+	if !ok {
+		return capacity.EnergyType, capacity.CapacityValue
+	}
+	for _, stat := range plug.InvestmentStats {
+		if stat.StatTypeHash == energyType.CapacityStatHash {
+			return energyType.EnumValue, stat.Value
+		}
+	}
+	return energyType.EnumValue, capacity.CapacityValue
+}
+
+// initialPlugs returns the hash of the initial plug in each of an item's sockets, which is 0 for empty sockets.
+func initialPlugs(item InventoryItemEntity) []uint32 {
+	plugs := make([]uint32, len(item.Sockets.SocketEntries))
+	for i, socket := range item.Sockets.SocketEntries {
+		plugs[i] = socket.SingleInitialItemHash
+	}
+	return plugs
+}
+
+// energy returns the energy of an item with plugHashes in its sockets, ignoring the socket at skip.
+func (c PlugChecker) energy(plugHashes []uint32, skip int) ItemEnergy {
+	var energy ItemEnergy
+	for i, hash := range plugHashes {
+		plug, ok := c.Items[hash]
+		if i == skip || !ok {
+			continue
+		}
+		if t, capacity := c.EnergyTypes.plugCapacity(plug); capacity > 0 {
+			energy.Type = t
+			energy.Capacity += capacity
+		}
+		_, cost := c.EnergyTypes.plugCost(plug)
+		energy.Used += cost
+	}
+	return energy
+}
+
+// CanInsert returns nil if plug can be inserted into the socket at socketIndex of item, with every other socket
+// holding its initial plug. Otherwise it returns an InsertError explaining the first restriction the plug fails.
+func (c PlugChecker) CanInsert(item InventoryItemEntity, socketIndex int, plug InventoryItemEntity) error {
+	return c.canInsert(item, initialPlugs(item), socketIndex, plug)
+}
+
+// canInsert is CanInsert with plugHashes in the item's sockets.
+func (c PlugChecker) canInsert(item InventoryItemEntity, plugHashes []uint32, socketIndex int, plug InventoryItemEntity) error {
+	reject := func(reason InsertRejection, format string, args ...interface{}) error {
+		return InsertError{plug.Hash, socketIndex, reason, fmt.Sprintf(format, args...)}
+	}
+
+	if socketIndex < 0 || socketIndex >= len(item.Sockets.SocketEntries) {
+		return reject(RejectedSocket, "item %d has %d sockets", item.Hash, len(item.Sockets.SocketEntries))
+	}
+	socketTypeHash := item.Sockets.SocketEntries[socketIndex].SocketTypeHash
+	socketType, ok := c.SocketTypes[socketTypeHash]
+	if !ok {
+		return reject(RejectedSocket, "socket type %d is not a known SocketTypeEntity", socketTypeHash)
+	}
+
+	allowed := false
+	for _, entry := range socketType.PlugWhitelist {
+		if entry.CategoryHash == plug.Plug.PlugCategoryHash {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return reject(RejectedCategory, "socket type %d doesn't accept plug category %q", socketTypeHash, plug.Plug.PlugCategoryIdentifier)
+	}
+
+	if plug.ClassType != Class_Unknown && item.ClassType != Class_Unknown && plug.ClassType != item.ClassType {
+		return reject(RejectedClass, "plug is for %s but item is for %s", plug.ClassType, item.ClassType)
+	}
+
+	costType, cost := c.EnergyTypes.plugCost(plug)
+	if cost == 0 && costType == EnergyType_Any {
+		return nil
+	}
+	energy := c.energy(plugHashes, socketIndex)
+	if costType != EnergyType_Any && energy.Type != EnergyType_Any && costType != energy.Type {
+		return reject(RejectedEnergyType, "plug costs %s energy but item has %s energy", costType, energy.Type)
+	}
+	if cost > energy.Unused() {
+		return reject(RejectedEnergyCapacity, "plug costs %d energy but item has %d of %d unused", cost, energy.Unused(), energy.Capacity)
+	}
+	return nil
+}
+
+// CompatiblePlugs returns every plug that can be inserted into the socket at socketIndex of item, ordered by hash.
+// The candidates are every item whose plug category is allowed by the socket type, so this includes plugs that
+// the socket only offers from the player's inventory, such as mods.
+func (c PlugChecker) CompatiblePlugs(item InventoryItemEntity, socketIndex int) ([]InventoryItemEntity, error) {
+	if socketIndex < 0 || socketIndex >= len(item.Sockets.SocketEntries) {
+		return nil, InsertError{0, socketIndex, RejectedSocket, fmt.Sprintf("item %d has %d sockets", item.Hash, len(item.Sockets.SocketEntries))}
+	}
+	socketTypeHash := item.Sockets.SocketEntries[socketIndex].SocketTypeHash
+	socketType, ok := c.SocketTypes[socketTypeHash]
+	if !ok {
+		return nil, EntityError{SocketTypeDefinition{}.Name(), socketTypeHash}
+	}
+
+	categories := map[uint32]bool{}
+	for _, entry := range socketType.PlugWhitelist {
+		categories[entry.CategoryHash] = true
+	}
+
+	plugHashes := initialPlugs(item)
+	var plugs []InventoryItemEntity
+	for _, candidate := range c.Items {
+		if !categories[candidate.Plug.PlugCategoryHash] {
+			continue
+		}
+		if c.canInsert(item, plugHashes, socketIndex, candidate) == nil {
+			plugs = append(plugs, candidate)
+		}
+	}
+	sort.Slice(plugs, func(i, j int) bool { return plugs[i].Hash < plugs[j].Hash })
+	return plugs, nil
+}
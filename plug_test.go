@@ -0,0 +1,145 @@
+package destiny2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlugChecker(t *testing.T) {
+	const (
+		modCategory    = 100
+		energyCategory = 200
+		modSocket      = 1
+		energySocket   = 2
+	)
+	mod := func(hash uint32, class Class, energyType EnergyType, cost int32) InventoryItemEntity {
+		return InventoryItemEntity{
+			ClassType:      class,
+			Plug:           ItemPlug{PlugCategoryHash: modCategory, PlugCategoryIdentifier: "enhancements.v2_general", EnergyCost: EnergyCostEntry{EnergyCost: cost, EnergyType: energyType}},
+			EntityMetadata: EntityMetadata{Hash: hash},
+		}
+	}
+	items := InventoryItemDefinition{
+		// An empty mod socket and a capacity of 7 arc energy.
+		10: mod(10, Class_Unknown, EnergyType_Any, 0),
+		11: {ClassType: Class_Unknown, Plug: ItemPlug{PlugCategoryHash: energyCategory, EnergyCapacity: EnergyCapacityEntry{CapacityValue: 7, EnergyType: EnergyType_Arc}}, EntityMetadata: EntityMetadata{Hash: 11}},
+		// A mod already inserted, costing 2 energy.
+		12: mod(12, Class_Unknown, EnergyType_Any, 2),
+
+		20: mod(20, Class_Unknown, EnergyType_Arc, 3),
+		21: mod(21, Class_Unknown, EnergyType_Any, 5),
+		22: mod(22, Class_Unknown, EnergyType_Any, 6),
+		23: mod(23, Class_Unknown, EnergyType_Void, 1),
+		24: mod(24, Class_Warlock, EnergyType_Any, 1),
+		25: mod(25, Class_Hunter, EnergyType_Any, 1),
+		26: {ClassType: Class_Unknown, Plug: ItemPlug{PlugCategoryHash: 300, PlugCategoryIdentifier: "shader"}, EntityMetadata: EntityMetadata{Hash: 26}},
+	}
+	checker := PlugChecker{
+		Items: items,
+		SocketTypes: SocketTypeDefinition{
+			modSocket:    {PlugWhitelist: []PlugWhitelistEntry{{CategoryHash: modCategory}}},
+			energySocket: {PlugWhitelist: []PlugWhitelistEntry{{CategoryHash: energyCategory}}},
+		},
+	}
+	armor := InventoryItemEntity{
+		ClassType: Class_Hunter,
+		Sockets: ItemSocketBlock{SocketEntries: []ItemSocketEntry{
+			{SocketTypeHash: modSocket, SingleInitialItemHash: 10},
+			{SocketTypeHash: modSocket, SingleInitialItemHash: 12},
+			{SocketTypeHash: energySocket, SingleInitialItemHash: 11},
+			{SocketTypeHash: 99},
+		}},
+	}
+
+	tests := []struct {
+		plug        uint32
+		socketIndex int
+		want        InsertRejection
+		ok          bool
+	}{
+		{plug: 20, socketIndex: 0, ok: true},
+		{plug: 21, socketIndex: 0, ok: true},
+		{plug: 22, socketIndex: 0, want: RejectedEnergyCapacity},
+		// Replacing the inserted mod frees its energy.
+		{plug: 22, socketIndex: 1, ok: true},
+		{plug: 23, socketIndex: 0, want: RejectedEnergyType},
+		{plug: 24, socketIndex: 0, want: RejectedClass},
+		{plug: 25, socketIndex: 0, ok: true},
+		{plug: 26, socketIndex: 0, want: RejectedCategory},
+		{plug: 20, socketIndex: 3, want: RejectedSocket},
+		{plug: 20, socketIndex: 4, want: RejectedSocket},
+	}
+	for _, test := range tests {
+		err := checker.CanInsert(armor, test.socketIndex, items[test.plug])
+		if test.ok {
+			if err != nil {
+				t.Errorf("CanInsert(socket %d, plug %d): got error %v, want none", test.socketIndex, test.plug, err)
+			}
+			continue
+		}
+		var insertErr InsertError
+		if !errors.As(err, &insertErr) {
+			t.Errorf("CanInsert(socket %d, plug %d): got error %v, want InsertError", test.socketIndex, test.plug, err)
+			continue
+		}
+		if insertErr.Reason != test.want {
+			t.Errorf("CanInsert(socket %d, plug %d): got reason %s, want %s", test.socketIndex, test.plug, insertErr.Reason, test.want)
+		}
+	}
+
+	plugs, err := checker.CompatiblePlugs(armor, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint32
+	for _, plug := range plugs {
+		got = append(got, plug.Hash)
+	}
+	if diff := cmp.Diff([]uint32{10, 12, 20, 21, 25}, got); diff != "" {
+		t.Errorf("CompatiblePlugs() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := checker.CompatiblePlugs(armor, 3); !errors.As(err, &EntityError{}) {
+		t.Errorf("CompatiblePlugs() of unknown socket type: got error %v, want EntityError", err)
+	}
+}
+
+func TestPlugCheckerEnergyTypes(t *testing.T) {
+	const (
+		arcHash      = 1
+		costStat     = 2
+		capacityStat = 3
+		modSocket    = 1
+	)
+	energyTypes := EnergyTypeDefinition{arcHash: {EnumValue: EnergyType_Arc, CostStatHash: costStat, CapacityStatHash: capacityStat}}
+	// The investment stats disagree with EnergyCost and EnergyCapacity, and take precedence over them.
+	capacity := InventoryItemEntity{
+		Plug:            ItemPlug{PlugCategoryHash: 200, EnergyCapacity: EnergyCapacityEntry{CapacityValue: 10, EnergyTypeHash: arcHash}},
+		InvestmentStats: []ItemInvestmentStat{{StatTypeHash: capacityStat, Value: 5}},
+		EntityMetadata:  EntityMetadata{Hash: 11},
+	}
+	mod := InventoryItemEntity{
+		Plug:            ItemPlug{PlugCategoryHash: 100, EnergyCost: EnergyCostEntry{EnergyCost: 1, EnergyTypeHash: arcHash}},
+		InvestmentStats: []ItemInvestmentStat{{StatTypeHash: costStat, Value: 6}},
+		EntityMetadata:  EntityMetadata{Hash: 20},
+	}
+	checker := PlugChecker{
+		Items:       InventoryItemDefinition{11: capacity, 20: mod},
+		SocketTypes: SocketTypeDefinition{modSocket: {PlugWhitelist: []PlugWhitelistEntry{{CategoryHash: 100}}}},
+		EnergyTypes: energyTypes,
+	}
+	armor := InventoryItemEntity{Sockets: ItemSocketBlock{SocketEntries: []ItemSocketEntry{
+		{SocketTypeHash: modSocket},
+		{SingleInitialItemHash: 11},
+	}}}
+
+	planner := EnergyPlanner{Items: checker.Items, EnergyTypes: energyTypes}
+	if got := planner.ModCost(mod); got != 6 {
+		t.Errorf("ModCost: got %d, want 6", got)
+	}
+	var insertErr InsertError
+	if err := checker.CanInsert(armor, 0, mod); !errors.As(err, &insertErr) || insertErr.Reason != RejectedEnergyCapacity {
+		t.Errorf("CanInsert with a cost of 6 and capacity of 5: got %v, want %s", err, RejectedEnergyCapacity)
+	}
+}
@@ -0,0 +1,99 @@
+package destiny2
+
+import "sort"
+
+// ProgressionLevel is a position in a progression, such as a Valor rank or season pass level.
+type ProgressionLevel struct {
+	// Level is the number of steps completed, counting each repetition of a repeatable last step.
+	Level int32
+	// StepIndex is the index in ProgressionEntity.Steps of the step being progressed, or of the last step if the
+	// progression is complete.
+	StepIndex int
+	// ProgressToNextLevel is the progress earned towards completing the current step.
+	ProgressToNextLevel int32
+	// NextLevelAt is the total progress needed to complete the current step.
+	NextLevelAt int32
+	// Remaining is the progress left to complete the current step, which is 0 if the progression is complete.
+	Remaining int32
+	// Complete determines if every step is completed and the last step can't be repeated.
+	Complete bool
+}
+
+// ProgressionLevelAt returns the level of a progression with progress earned since it was last reset.
+// Each step requires its ProgressTotal after the previous steps are completed. Once every step is complete,
+// further progress repeats the last step if the progression RepeatLastStep, and is otherwise ignored.
+func ProgressionLevelAt(progression ProgressionEntity, progress int32) ProgressionLevel {
+	steps := progression.Steps
+	if len(steps) == 0 {
+		return ProgressionLevel{Complete: true}
+	}
+	if progress < 0 {
+		progress = 0
+	}
+
+	for i, step := range steps {
+		if progress < step.ProgressTotal {
+			return ProgressionLevel{
+				Level:               int32(i),
+				StepIndex:           i,
+				ProgressToNextLevel: progress,
+				NextLevelAt:         step.ProgressTotal,
+				Remaining:           step.ProgressTotal - progress,
+			}
+		}
+		progress -= step.ProgressTotal
+	}
+
+	last := steps[len(steps)-1]
+	level := ProgressionLevel{Level: int32(len(steps)), StepIndex: len(steps) - 1, NextLevelAt: last.ProgressTotal}
+	if !progression.RepeatLastStep || last.ProgressTotal <= 0 {
+		level.ProgressToNextLevel = last.ProgressTotal
+		level.Complete = true
+		return level
+	}
+	level.Level += progress / last.ProgressTotal
+	level.ProgressToNextLevel = progress % last.ProgressTotal
+	level.Remaining = last.ProgressTotal - level.ProgressToNextLevel
+	return level
+}
+
+// ResetProgressionLevelAt returns the level of a progression that is reset to its first step each time every step
+// is completed, such as Valor and Glory, along with the number of resets, from the progress earned across every reset.
+// A progression is only reset once progress is earned beyond its last step, so progress that exactly completes
+// every step is complete with no further resets, as ProgressionLevelAt reports it.
+// Progressions that RepeatLastStep never need resetting, so their resets are always 0.
+func ResetProgressionLevelAt(progression ProgressionEntity, totalProgress int32) (ProgressionLevel, int32) {
+	var total int32
+	for _, step := range progression.Steps {
+		total += step.ProgressTotal
+	}
+	if progression.RepeatLastStep || total <= 0 || totalProgress <= total {
+		return ProgressionLevelAt(progression, totalProgress), 0
+	}
+	resets := (totalProgress - 1) / total
+	return ProgressionLevelAt(progression, totalProgress-resets*total), resets
+}
+
+// InterpolateCurve returns the weight of value on a curve such as ProgressionLevelRequirementEntity.RequirementCurve,
+// which maps a progression level to a requirement. Weights between points are linearly interpolated and values
+// outside the curve are clamped to its ends. An empty curve weighs 0.
+func InterpolateCurve(curve []InterpolationPointFloat, value float32) float32 {
+	if len(curve) == 0 {
+		return 0
+	}
+	first, last := curve[0], curve[len(curve)-1]
+	if value <= first.Value {
+		return first.Weight
+	}
+	if value >= last.Value {
+		return last.Weight
+	}
+
+	i := sort.Search(len(curve), func(i int) bool { return curve[i].Value >= value })
+	start, end := curve[i-1], curve[i]
+	if end.Value == value {
+		return end.Weight
+	}
+	t := (value - start.Value) / (end.Value - start.Value)
+	return start.Weight + t*(end.Weight-start.Weight)
+}
@@ -0,0 +1,133 @@
+package destiny2
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Hashes of the entities in the testdata/progression excerpts, which are small enough to check in directly.
+const (
+	valorHash            = 2083746873
+	seasonPassHash       = 1628407317
+	seasonPassLevelsHash = 4249081773
+)
+
+// fulfillExcerpt fulfills a contract from its excerpt in testdata/progression.
+func fulfillExcerpt(t *testing.T, contract Contract) {
+	t.Helper()
+	data, err := ioutil.ReadFile(fmt.Sprintf("testdata/progression/%s.json", contract.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := contract.Unmarshal(data); err != nil {
+		t.Fatalf("Unmarshal(%s): %v", contract.Name(), err)
+	}
+}
+
+func progressions(t *testing.T) ProgressionDefinition {
+	var def ProgressionDefinition
+	fulfillExcerpt(t, &def)
+	return def
+}
+
+func TestProgressionLevelAt(t *testing.T) {
+	def := progressions(t)
+	// Valor's 15 ranks before Legend require 37,500 points in total.
+	tests := []struct {
+		name        string
+		progression uint32
+		progress    int32
+		want        ProgressionLevel
+	}{
+		{name: "start", progression: valorHash, want: ProgressionLevel{NextLevelAt: 750, Remaining: 750}},
+		{name: "negative", progression: valorHash, progress: -10, want: ProgressionLevel{NextLevelAt: 750, Remaining: 750}},
+		{name: "within step", progression: valorHash, progress: 500, want: ProgressionLevel{ProgressToNextLevel: 500, NextLevelAt: 750, Remaining: 250}},
+		{name: "step boundary", progression: valorHash, progress: 750, want: ProgressionLevel{Level: 1, StepIndex: 1, NextLevelAt: 1000, Remaining: 1000}},
+		{name: "later step", progression: valorHash, progress: 4000, want: ProgressionLevel{Level: 3, StepIndex: 3, ProgressToNextLevel: 1000, NextLevelAt: 1500, Remaining: 500}},
+		{name: "last rank", progression: valorHash, progress: 37499, want: ProgressionLevel{Level: 14, StepIndex: 14, ProgressToNextLevel: 4249, NextLevelAt: 4250, Remaining: 1}},
+		{name: "complete", progression: valorHash, progress: 37500, want: ProgressionLevel{Level: 16, StepIndex: 15, Complete: true}},
+		{name: "beyond complete", progression: valorHash, progress: 99999, want: ProgressionLevel{Level: 16, StepIndex: 15, Complete: true}},
+		{name: "before repeat", progression: seasonPassHash, progress: 9950000, want: ProgressionLevel{Level: 99, StepIndex: 99, ProgressToNextLevel: 50000, NextLevelAt: 100000, Remaining: 50000}},
+		{name: "repeated", progression: seasonPassHash, progress: 12345678, want: ProgressionLevel{Level: 123, StepIndex: 99, ProgressToNextLevel: 45678, NextLevelAt: 100000, Remaining: 54322}},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, ProgressionLevelAt(def[test.progression], test.progress)); diff != "" {
+				t.Errorf("ProgressionLevelAt(%d) (-want +got):\n%s", test.progress, diff)
+			}
+		})
+	}
+
+	if diff := cmp.Diff(ProgressionLevel{Complete: true}, ProgressionLevelAt(ProgressionEntity{}, 10)); diff != "" {
+		t.Errorf("ProgressionLevelAt() without steps (-want +got):\n%s", diff)
+	}
+}
+
+func TestResetProgressionLevelAt(t *testing.T) {
+	def := progressions(t)
+	tests := []struct {
+		name        string
+		progression uint32
+		progress    int32
+		want        ProgressionLevel
+		wantResets  int32
+	}{
+		{name: "no resets", progression: valorHash, progress: 4000, want: ProgressionLevel{Level: 3, StepIndex: 3, ProgressToNextLevel: 1000, NextLevelAt: 1500, Remaining: 500}},
+		{name: "complete without reset", progression: valorHash, progress: 37500, want: ProgressionLevel{Level: 16, StepIndex: 15, Complete: true}},
+		{name: "reset", progression: valorHash, progress: 37501, want: ProgressionLevel{ProgressToNextLevel: 1, NextLevelAt: 750, Remaining: 749}, wantResets: 1},
+		{name: "complete after reset", progression: valorHash, progress: 2 * 37500, want: ProgressionLevel{Level: 16, StepIndex: 15, Complete: true}, wantResets: 1},
+		{name: "several resets", progression: valorHash, progress: 3*37500 + 800, want: ProgressionLevel{Level: 1, StepIndex: 1, ProgressToNextLevel: 50, NextLevelAt: 1000, Remaining: 950}, wantResets: 3},
+		{name: "repeating", progression: seasonPassHash, progress: 12345678, want: ProgressionLevel{Level: 123, StepIndex: 99, ProgressToNextLevel: 45678, NextLevelAt: 100000, Remaining: 54322}},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, resets := ResetProgressionLevelAt(def[test.progression], test.progress)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ResetProgressionLevelAt(%d) (-want +got):\n%s", test.progress, diff)
+			}
+			if resets != test.wantResets {
+				t.Errorf("ResetProgressionLevelAt(%d) resets = %d, want %d", test.progress, resets, test.wantResets)
+			}
+		})
+	}
+}
+
+func TestInterpolateCurve(t *testing.T) {
+	var def ProgressionLevelRequirementDefinition
+	fulfillExcerpt(t, &def)
+	requirement := def[seasonPassLevelsHash]
+	if requirement.ProgressionHash != seasonPassHash {
+		t.Fatalf("ProgressionHash = %d, want %d", requirement.ProgressionHash, seasonPassHash)
+	}
+
+	tests := []struct {
+		name        string
+		value, want float32
+	}{
+		{name: "before curve", value: -5, want: 0},
+		{name: "first point", value: 0, want: 0},
+		{name: "between points", value: 0.5, want: 0.5},
+		{name: "point", value: 1, want: 1},
+		{name: "steep segment", value: 13, want: 655.5},
+		{name: "shallow segment", value: 30, want: 1312},
+		{name: "last point", value: 100, want: 1330},
+		{name: "after curve", value: 150, want: 1330},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if got := InterpolateCurve(requirement.RequirementCurve, test.value); got != test.want {
+				t.Errorf("InterpolateCurve(%v) = %v, want %v", test.value, got, test.want)
+			}
+		})
+	}
+
+	if got := InterpolateCurve(nil, 5); got != 0 {
+		t.Errorf("InterpolateCurve(nil, 5) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,22 @@
+package destiny2
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Every entity can be converted to and from its protobuf message in the service/protos/manifest package
+// with its Proto method and the matching FromProto function, such as InventoryItemEntityFromProto.
+// Conversion is lossless, except that empty slices and maps become nil and times keep their instant but not their location.
+
+//go:generate go run gen_proto.go
+//go:generate protoc --proto_path=service --go_out=service --go_opt=paths=source_relative --go-grpc_out=service --go-grpc_opt=paths=source_relative protos/manifest/manifest.proto protos/manifest/manifest_service.proto
+
+// fromProtoTime converts a protobuf timestamp, which is nil for the zero time.Time in some encodings.
+func fromProtoTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,100 @@
+package destiny2
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+)
+
+// fill sets every field reachable from v to a distinct non-zero value.
+func fill(v reflect.Value, next *int) {
+	*next++
+	n := *next
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Date(2021, 8, 24, 17, 0, n, 0, time.UTC)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			fill(v.Field(i), next)
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fill(v.Index(i), next)
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		for i := 0; i < 2; i++ {
+			key, elem := reflect.New(v.Type().Key()).Elem(), reflect.New(v.Type().Elem()).Elem()
+			fill(key, next)
+			fill(elem, next)
+			v.SetMapIndex(key, elem)
+		}
+	case reflect.String:
+		v.SetString(fmt.Sprintf("value %d", n))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		v.SetInt(int64(n))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		v.SetUint(uint64(n % 250))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(n) + 0.5)
+	}
+}
+
+func TestEntityProtoRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		// entity is a pointer to an entity with a Proto method.
+		entity interface{}
+		// fromProto converts the entity's message back, such as InventoryItemEntityFromProto.
+		fromProto interface{}
+	}{
+		{"InventoryItemEntity", &InventoryItemEntity{}, InventoryItemEntityFromProto},
+		{"VendorEntity", &VendorEntity{}, VendorEntityFromProto},
+		{"ActivityEntity", &ActivityEntity{}, ActivityEntityFromProto},
+		{"RecordEntity", &RecordEntity{}, RecordEntityFromProto},
+		{"CollectibleEntity", &CollectibleEntity{}, CollectibleEntityFromProto},
+		{"SocketTypeEntity", &SocketTypeEntity{}, SocketTypeEntityFromProto},
+		{"PlugSetEntity", &PlugSetEntity{}, PlugSetEntityFromProto},
+		{"StatGroupEntity", &StatGroupEntity{}, StatGroupEntityFromProto},
+		{"SeasonEntity", &SeasonEntity{}, SeasonEntityFromProto},
+		{"RaceEntity", &RaceEntity{}, RaceEntityFromProto},
+		{"MilestoneEntity", &MilestoneEntity{}, MilestoneEntityFromProto},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var next int
+			fill(reflect.ValueOf(test.entity).Elem(), &next)
+			want := reflect.ValueOf(test.entity).Elem().Interface()
+
+			msg := reflect.ValueOf(test.entity).MethodByName("Proto").Call(nil)[0]
+			if err := wireRoundTrip(msg.Interface().(proto.Message)); err != nil {
+				t.Fatal(err)
+			}
+			got := reflect.ValueOf(test.fromProto).Call([]reflect.Value{msg})[0].Interface()
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("%s round trip: (-want +got)\n%s", test.name, diff)
+			}
+		})
+	}
+}
+
+// wireRoundTrip marshals and unmarshals m in place, to verify the message survives the wire format.
+func wireRoundTrip(m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	proto.Reset(m)
+	return proto.Unmarshal(data, m)
+}
@@ -1,14 +1,11 @@
 package destiny2
 
 import (
-	"archive/zip"
-	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"net/http"
 	"os"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -33,12 +30,7 @@ func (r *BungieAPIReader) ReadContract(contract Contract, path string, useMobile
 		return r.fromMobile(contract, path)
 	}
 
-	resp, err := http.Get(fmt.Sprintf("https://www.bungie.net%s", path))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	return ioutil.ReadAll(resp.Body)
+	return download(path, verifyJSON(path))
 }
 
 func (r *BungieAPIReader) fromMobile(contract Contract, path string) ([]byte, error) {
@@ -54,7 +46,7 @@ func (r *BungieAPIReader) fromMobile(contract Contract, path string) ([]byte, er
 	cachedName := contract.Name() + path
 	if _, ok := r.cachedMobileManifests[cachedName]; !ok {
 		// First time getting the mobile manifest for this contract path.
-		if err := r.createTempDB(cachedName, path); err != nil {
+		if err := r.createTempDB(cachedName, contract.Name(), path); err != nil {
 			return nil, err
 		}
 	}
@@ -68,48 +60,42 @@ func (r *BungieAPIReader) fromMobile(contract Contract, path string) ([]byte, er
 }
 
 // createTempDB creates a temporary file with the sqlite destiny 2 mobile manifest.
-func (r *BungieAPIReader) createTempDB(name, path string) error {
-	resp, err := http.Get("https://www.bungie.net" + path)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
-
-	zippedDB := bytes.NewReader(body)
-	zipReader, err := zip.NewReader(zippedDB, zippedDB.Size())
-	if err != nil {
+// The download is verified to be a zipped SQLite database containing the contract's table before it is used.
+func (r *BungieAPIReader) createTempDB(name, contractName, path string) error {
+	var content []byte
+	_, err := download(path, func(body []byte) error {
+		var err error
+		content, err = unzipDB(path, body)
 		return err
-	}
-
-	f := zipReader.File[0]
-	fc, err := f.Open()
+	})
 	if err != nil {
 		return err
 	}
-	defer fc.Close()
 
-	content, err := ioutil.ReadAll(fc)
+	tempDB, err := ioutil.TempFile("", "d2manifest")
 	if err != nil {
 		return err
 	}
+	tempDB.Close()
+	dbPath := tempDB.Name()
 
-	tempDB, err := ioutil.TempFile("", "d2manifest")
-	if err != nil {
+	if err := writeFileAtomic(dbPath, writeBytes(content)); err != nil {
+		os.Remove(dbPath)
 		return err
 	}
-
-	if err := ioutil.WriteFile(tempDB.Name(), content, 0644); err != nil {
+	if err := verifyTable(path, dbPath, contractName); err != nil {
+		os.Remove(dbPath)
 		return err
 	}
-	r.cachedMobileManifests[name] = tempDB.Name()
+	r.cachedMobileManifests[name] = dbPath
 	return nil
 }
 
+// readsNetwork is always true since contracts are downloaded from Bungie.net.
+func (r *BungieAPIReader) readsNetwork() bool {
+	return true
+}
+
 // Close removes temporary mobile databases.
 func (r *BungieAPIReader) Close() error {
 	for _, db := range r.cachedMobileManifests {
@@ -0,0 +1,203 @@
+package destiny2
+
+// RollPlug is a plug that can be in a perk column of a weapon.
+type RollPlug struct {
+	// PlugItemHash is the hash of a related InventoryItemEntity.
+	PlugItemHash uint32
+	// CurrentlyCanRoll determines if this plug can be rolled on the current version of the item,
+	// rather than only being found on copies of the item that dropped in the past.
+	CurrentlyCanRoll bool
+}
+
+// PerkColumn is a socket of an item whose plug is chosen when the item drops, such as a weapon's barrel or trait.
+type PerkColumn struct {
+	// SocketIndex is the index of the socket in InventoryItemEntity.Sockets.SocketEntries.
+	SocketIndex int
+	// Plugs are every plug that can be in this socket, without duplicates.
+	Plugs []RollPlug
+}
+
+// Roll is one combination of plugs in an item's perk columns.
+type Roll struct {
+	// PlugItemHashes are the hashes of the plug in each perk column, in column order.
+	PlugItemHashes []uint32
+	// CurrentlyCanRoll determines if every plug in this roll can be rolled on the current version of the item.
+	CurrentlyCanRoll bool
+}
+
+// RollEnumerator enumerates the possible rolls of items from their sockets and plug sets.
+type RollEnumerator struct {
+	// Items are the items plugs refer to.
+	Items InventoryItemDefinition
+	// PlugSets are the plug sets sockets refer to.
+	PlugSets PlugSetDefinition
+	// Stats calculates the stats of each roll.
+	Stats StatCalculator
+	// CurrentOnly excludes plugs that can no longer be rolled from perk columns.
+	CurrentOnly bool
+	// IsPerkColumn determines if a socket is a perk column. If IsPerkColumn is nil, sockets with a randomized plug set are
+	// perk columns, as are sockets with a reusable plug set that can't be changed with plugs from the player's inventory
+	// or unlocked plugs, which excludes mods, shaders and ornaments.
+	IsPerkColumn func(socket ItemSocketEntry) bool
+}
+
+// isPerkColumn is the default RollEnumerator.IsPerkColumn.
+func isPerkColumn(socket ItemSocketEntry) bool {
+	if socket.RandomizedPlugSetHash != 0 {
+		return true
+	}
+	const playerSources SocketPlugSources = SocketPlug_InventorySourced | SocketPlug_ProfilePlugSet | SocketPlug_CharacterPlugSet
+	return socket.ReusablePlugSetHash != 0 && socket.PlugSources&playerSources == 0
+}
+
+// Columns returns the perk columns of an item, in socket order.
+// The plugs in a socket's randomized plug set are used if it has one, otherwise those in its reusable plug set.
+func (e RollEnumerator) Columns(item InventoryItemEntity) ([]PerkColumn, error) {
+	isPerk := e.IsPerkColumn
+	if isPerk == nil {
+		isPerk = isPerkColumn
+	}
+
+	var columns []PerkColumn
+	for i, socket := range item.Sockets.SocketEntries {
+		if !isPerk(socket) {
+			continue
+		}
+
+		column := PerkColumn{SocketIndex: i}
+		var candidates []ItemSocketEntryPlugItemRandomized
+		if hash := socket.RandomizedPlugSetHash; hash != 0 || socket.ReusablePlugSetHash != 0 {
+			if hash == 0 {
+				hash = socket.ReusablePlugSetHash
+			}
+			plugSet, ok := e.PlugSets[hash]
+			if !ok {
+				return nil, EntityError{PlugSetDefinition{}.Name(), hash}
+			}
+			candidates = plugSet.ReusablePlugItems
+		} else {
+			for _, plug := range socket.ReusablePlugItems {
+				candidates = append(candidates, ItemSocketEntryPlugItemRandomized{PlugItemHash: plug.PlugItemHash, CurrentlyCanRoll: true})
+			}
+		}
+
+		// Plug sets list a plug once for each way it can roll, so merge duplicates.
+		seen := map[uint32]int{}
+		for _, candidate := range candidates {
+			if e.CurrentOnly && !candidate.CurrentlyCanRoll {
+				continue
+			}
+			if j, ok := seen[candidate.PlugItemHash]; ok {
+				column.Plugs[j].CurrentlyCanRoll = column.Plugs[j].CurrentlyCanRoll || candidate.CurrentlyCanRoll
+				continue
+			}
+			seen[candidate.PlugItemHash] = len(column.Plugs)
+			column.Plugs = append(column.Plugs, RollPlug{candidate.PlugItemHash, candidate.CurrentlyCanRoll})
+		}
+		if len(column.Plugs) > 0 {
+			columns = append(columns, column)
+		}
+	}
+	return columns, nil
+}
+
+// RollIterator iterates over every combination of plugs in a set of perk columns without holding them in memory.
+//
+//	it := NewRollIterator(columns)
+//	for it.Next() {
+//		roll := it.Roll()
+//	}
+type RollIterator struct {
+	columns []PerkColumn
+	indexes []int
+	started bool
+	done    bool
+}
+
+// NewRollIterator returns an iterator over the cartesian product of the plugs in columns, varying the last column fastest.
+func NewRollIterator(columns []PerkColumn) *RollIterator {
+	return &RollIterator{columns: columns, indexes: make([]int, len(columns))}
+}
+
+// Count returns the number of rolls in the product, which is 0 if there are no columns.
+func (it *RollIterator) Count() int {
+	if len(it.columns) == 0 {
+		return 0
+	}
+	count := 1
+	for _, column := range it.columns {
+		count *= len(column.Plugs)
+	}
+	return count
+}
+
+// Next advances to the next roll and reports whether there is one.
+func (it *RollIterator) Next() bool {
+	if it.done || it.Count() == 0 {
+		it.done = true
+		return false
+	}
+	if !it.started {
+		it.started = true
+		return true
+	}
+	for i := len(it.indexes) - 1; i >= 0; i-- {
+		it.indexes[i]++
+		if it.indexes[i] < len(it.columns[i].Plugs) {
+			return true
+		}
+		it.indexes[i] = 0
+	}
+	it.done = true
+	return false
+}
+
+// Roll returns the current roll.
+func (it *RollIterator) Roll() Roll {
+	roll := Roll{PlugItemHashes: make([]uint32, len(it.columns)), CurrentlyCanRoll: true}
+	for i, column := range it.columns {
+		plug := column.Plugs[it.indexes[i]]
+		roll.PlugItemHashes[i] = plug.PlugItemHash
+		roll.CurrentlyCanRoll = roll.CurrentlyCanRoll && plug.CurrentlyCanRoll
+	}
+	return roll
+}
+
+// Rolls returns an iterator over every roll of an item.
+func (e RollEnumerator) Rolls(item InventoryItemEntity) (*RollIterator, error) {
+	columns, err := e.Columns(item)
+	if err != nil {
+		return nil, err
+	}
+	return NewRollIterator(columns), nil
+}
+
+// RollStats returns the stats displayed on an item with a roll. Sockets that aren't perk columns contribute the
+// stats of their initial plug, such as an intrinsic frame or a default masterwork.
+func (e RollEnumerator) RollStats(item InventoryItemEntity, roll Roll) ([]ItemStat, error) {
+	columns, err := e.Columns(item)
+	if err != nil {
+		return nil, err
+	}
+	inColumn := map[int]bool{}
+	for _, column := range columns {
+		inColumn[column.SocketIndex] = true
+	}
+
+	hashes := append([]uint32(nil), roll.PlugItemHashes...)
+	for i, socket := range item.Sockets.SocketEntries {
+		if !inColumn[i] && socket.SingleInitialItemHash != 0 {
+			hashes = append(hashes, socket.SingleInitialItemHash)
+		}
+	}
+
+	plugs := make([]InventoryItemEntity, len(hashes))
+	for i, hash := range hashes {
+		plug, ok := e.Items[hash]
+		if !ok {
+			return nil, EntityError{InventoryItemDefinition{}.Name(), hash}
+		}
+		plugs[i] = plug
+	}
+	return e.Stats.Stats(item, plugs...)
+}
@@ -0,0 +1,119 @@
+package destiny2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRollEnumerator(t *testing.T) {
+	const stability = 155624089
+	items := InventoryItemDefinition{
+		// Barrels.
+		10: {InvestmentStats: []ItemInvestmentStat{{StatTypeHash: stability, Value: 10}}},
+		11: {InvestmentStats: []ItemInvestmentStat{{StatTypeHash: stability, Value: -5}}},
+		// Traits.
+		20: {},
+		21: {},
+		22: {},
+		// Intrinsic frame.
+		30: {InvestmentStats: []ItemInvestmentStat{{StatTypeHash: stability, Value: 20}}},
+	}
+	plugSets := PlugSetDefinition{
+		1: {ReusablePlugItems: []ItemSocketEntryPlugItemRandomized{{PlugItemHash: 10, CurrentlyCanRoll: true}, {PlugItemHash: 11, CurrentlyCanRoll: true}}},
+		2: {ReusablePlugItems: []ItemSocketEntryPlugItemRandomized{
+			{PlugItemHash: 20, CurrentlyCanRoll: true},
+			{PlugItemHash: 21, CurrentlyCanRoll: false},
+			{PlugItemHash: 22, CurrentlyCanRoll: false},
+			{PlugItemHash: 22, CurrentlyCanRoll: true},
+		}},
+		3: {ReusablePlugItems: []ItemSocketEntryPlugItemRandomized{{PlugItemHash: 40, CurrentlyCanRoll: true}}},
+	}
+	weapon := InventoryItemEntity{
+		Stats: ItemStatBlock{StatGroupHash: 1},
+		InvestmentStats: []ItemInvestmentStat{
+			{StatTypeHash: stability, Value: 30},
+		},
+		Sockets: ItemSocketBlock{SocketEntries: []ItemSocketEntry{
+			{SingleInitialItemHash: 30},
+			{SingleInitialItemHash: 10, RandomizedPlugSetHash: 1},
+			{SingleInitialItemHash: 20, RandomizedPlugSetHash: 2},
+			// A shader socket, which can be changed with unlocked plugs.
+			{ReusablePlugSetHash: 3, PlugSources: SocketPlug_ReusablePlugItems | SocketPlug_ProfilePlugSet},
+		}},
+	}
+	e := RollEnumerator{
+		Items:    items,
+		PlugSets: plugSets,
+		Stats: StatCalculator{StatGroups: StatGroupDefinition{
+			1: {ScaledStats: []StatDisplay{{StatHash: stability, MaximumValue: 100, DisplayInterpolation: []InterpolationPoint{{0, 0}, {100, 50}}}}},
+		}},
+	}
+
+	columns, err := e.Columns(weapon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantColumns := []PerkColumn{
+		{SocketIndex: 1, Plugs: []RollPlug{{10, true}, {11, true}}},
+		{SocketIndex: 2, Plugs: []RollPlug{{20, true}, {21, false}, {22, true}}},
+	}
+	if diff := cmp.Diff(wantColumns, columns); diff != "" {
+		t.Errorf("Columns() mismatch (-want +got):\n%s", diff)
+	}
+
+	it, err := e.Rolls(weapon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := it.Count(), 6; got != want {
+		t.Errorf("Count(): got %d, want %d", got, want)
+	}
+	var rolls []Roll
+	var stabilities []int32
+	for it.Next() {
+		roll := it.Roll()
+		rolls = append(rolls, roll)
+		stats, err := e.RollStats(weapon, roll)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stabilities = append(stabilities, stats[0].Value)
+	}
+	wantRolls := []Roll{
+		{[]uint32{10, 20}, true},
+		{[]uint32{10, 21}, false},
+		{[]uint32{10, 22}, true},
+		{[]uint32{11, 20}, true},
+		{[]uint32{11, 21}, false},
+		{[]uint32{11, 22}, true},
+	}
+	if diff := cmp.Diff(wantRolls, rolls); diff != "" {
+		t.Errorf("rolls mismatch (-want +got):\n%s", diff)
+	}
+	// 30 from the weapon, 20 from its frame and 10 or -5 from its barrel, halved by the interpolation table.
+	if diff := cmp.Diff([]int32{30, 30, 30, 22, 22, 22}, stabilities); diff != "" {
+		t.Errorf("RollStats() stability mismatch (-want +got):\n%s", diff)
+	}
+	if it.Next() {
+		t.Error("Next() after the last roll: got true, want false")
+	}
+
+	e.CurrentOnly = true
+	it, err = e.Rolls(weapon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := it.Count(), 4; got != want {
+		t.Errorf("Count() of current rolls: got %d, want %d", got, want)
+	}
+
+	delete(e.PlugSets, 2)
+	if _, err := e.Rolls(weapon); !errors.As(err, &EntityError{}) {
+		t.Errorf("Rolls() with missing plug set: got error %v, want EntityError", err)
+	}
+	if NewRollIterator(nil).Next() {
+		t.Error("Next() without columns: got true, want false")
+	}
+}
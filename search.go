@@ -0,0 +1,101 @@
+package destiny2
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// FindEntity returns the entity in a fulfilled contract with a given hash.
+// Unlike Contract.Entity, it reports whether the entity exists.
+// The entity is looked up directly, without copying the contract, so it is cheap to call once per hash.
+func FindEntity(contract Contract, hash uint32) (interface{}, bool) {
+	v := reflect.Indirect(reflect.ValueOf(contract))
+	if v.Kind() != reflect.Map {
+		return nil, false
+	}
+	entity := v.MapIndex(reflect.ValueOf(hash).Convert(v.Type().Key()))
+	if !entity.IsValid() {
+		return nil, false
+	}
+	return entity.Interface(), true
+}
+
+// ContractEntities returns every entity in a fulfilled contract by hash. It copies the whole contract,
+// so FindEntity should be used to look up a few entities.
+func ContractEntities(contract Contract) map[uint32]interface{} {
+	v := reflect.Indirect(reflect.ValueOf(contract))
+	if v.Kind() != reflect.Map {
+		return nil
+	}
+
+	entities := make(map[uint32]interface{}, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		entities[uint32(iter.Key().Uint())] = iter.Value().Interface()
+	}
+	return entities
+}
+
+var displayPropertiesType = reflect.TypeOf(DisplayProperties{})
+
+// EntityDisplayProperties returns the DisplayProperties of an entity, if it has any.
+// Entities with specialized display properties, such as ProgressionDisplayProperties, return the embedded DisplayProperties.
+func EntityDisplayProperties(entity interface{}) (DisplayProperties, bool) {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+	if v.Kind() != reflect.Struct {
+		return DisplayProperties{}, false
+	}
+
+	field := v.FieldByName("DisplayProperties")
+	if !field.IsValid() {
+		return DisplayProperties{}, false
+	}
+	if field.Type() == displayPropertiesType {
+		return field.Interface().(DisplayProperties), true
+	}
+
+	if field.Kind() != reflect.Struct {
+		return DisplayProperties{}, false
+	}
+	embedded := field.FieldByName("DisplayProperties")
+	if !embedded.IsValid() || embedded.Type() != displayPropertiesType {
+		return DisplayProperties{}, false
+	}
+	return embedded.Interface().(DisplayProperties), true
+}
+
+// SearchContract returns the hashes of entities in a fulfilled contract whose name contains query, ignoring case.
+// Hashes are returned in ascending order and entities without display properties never match.
+func SearchContract(contract Contract, query string) []uint32 {
+	query = strings.ToLower(query)
+
+	var hashes []uint32
+	for hash, entity := range ContractEntities(contract) {
+		display, ok := EntityDisplayProperties(entity)
+		if !ok || display.Name == "" {
+			continue
+		}
+		if strings.Contains(strings.ToLower(display.Name), query) {
+			hashes = append(hashes, hash)
+		}
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	return hashes
+}
+
+// Contracts returns the names of the contracts available in this manifest, in alphabetical order.
+// Only contracts that NewContract can create are included; Bungie.net lists other definitions that this
+// package has no types for.
+func (m Manifest) Contracts() []string {
+	var names []string
+	for name := range m.contracts[language.English] {
+		if _, err := NewContract(name); err == nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
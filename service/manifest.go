@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/paranoiacblack/destiny2"
+	manifestpb "github.com/paranoiacblack/destiny2/service/protos/manifest"
+)
+
+type manifestServer struct {
+	manifestpb.UnimplementedManifestServer
+
+	source    destiny2.ManifestSource
+	contracts *destiny2.ContractCache
+}
+
+// NewManifestServer returns a server for the manifest.Manifest gRPC service which looks up entities in source.
+// Each contract is fulfilled once per locale and kept in memory until the version of source changes,
+// so many clients can share a single copy of the manifest.
+func NewManifestServer(source destiny2.ManifestSource) manifestpb.ManifestServer {
+	return &manifestServer{source: source, contracts: destiny2.NewContractCache(source)}
+}
+
+func (srv *manifestServer) GetVersion(ctx context.Context, in *emptypb.Empty) (*manifestpb.VersionResponse, error) {
+	return &manifestpb.VersionResponse{Version: srv.source.Version()}, nil
+}
+
+func (srv *manifestServer) ListContracts(ctx context.Context, in *emptypb.Empty) (*manifestpb.ContractsResponse, error) {
+	return &manifestpb.ContractsResponse{Contracts: srv.source.Contracts()}, nil
+}
+
+func (srv *manifestServer) GetEntity(ctx context.Context, in *manifestpb.EntityRequest) (*manifestpb.Entity, error) {
+	contract, err := srv.contract(in.Contract, in.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, ok := destiny2.FindEntity(contract, in.Hash)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s has no entity with hash %d", in.Contract, in.Hash)
+	}
+	return entityMessage(in.Contract, in.Hash, entity)
+}
+
+func (srv *manifestServer) BatchGetEntities(ctx context.Context, in *manifestpb.BatchEntityRequest) (*manifestpb.BatchEntityResponse, error) {
+	contract, err := srv.contract(in.Contract, in.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(manifestpb.BatchEntityResponse)
+	for _, hash := range in.Hashes {
+		entity, ok := destiny2.FindEntity(contract, hash)
+		if !ok {
+			resp.MissingHashes = append(resp.MissingHashes, hash)
+			continue
+		}
+
+		msg, err := entityMessage(in.Contract, hash, entity)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entities = append(resp.Entities, msg)
+	}
+	return resp, nil
+}
+
+func (srv *manifestServer) SearchEntities(ctx context.Context, in *manifestpb.SearchRequest) (*manifestpb.SearchResponse, error) {
+	if in.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is empty")
+	}
+
+	names := []string{in.Contract}
+	if in.Contract == "" {
+		names = srv.source.Contracts()
+	}
+
+	resp := new(manifestpb.SearchResponse)
+	for _, name := range names {
+		contract, err := srv.contract(name, in.Locale)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hash := range destiny2.SearchContract(contract, in.Query) {
+			if in.Limit > 0 && len(resp.Entities) >= int(in.Limit) {
+				return resp, nil
+			}
+
+			entity, _ := destiny2.FindEntity(contract, hash)
+			msg, err := entityMessage(name, hash, entity)
+			if err != nil {
+				return nil, err
+			}
+			resp.Entities = append(resp.Entities, msg)
+		}
+	}
+	return resp, nil
+}
+
+// contract returns the fulfilled contract with a given name and locale, fulfilling it if it isn't cached.
+func (srv *manifestServer) contract(name, locale string) (destiny2.Contract, error) {
+	if locale == "" {
+		locale = "en"
+	}
+	contract, err := srv.contracts.Contract(name, locale)
+	if errors.As(err, &destiny2.ContractError{}) || errors.As(err, &destiny2.LocaleError{}) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "fulfilling %s: %v", name, err)
+	}
+	return contract, nil
+}
+
+// entityMessage wraps an entity's protobuf representation in an Entity message.
+func entityMessage(contract string, hash uint32, entity interface{}) (*manifestpb.Entity, error) {
+	msg, ok := destiny2.EntityProto(entity)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "%s has no protobuf representation", contract)
+	}
+
+	any, err := anypb.New(msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &manifestpb.Entity{Contract: contract, Hash: hash, Entity: any}, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/paranoiacblack/destiny2"
+	manifestpb "github.com/paranoiacblack/destiny2/service/protos/manifest"
+)
+
+// fakeManifest serves contracts from JSON and counts how often each is fulfilled.
+type fakeManifest struct {
+	version   string
+	contracts map[string]string
+	fulfilled map[string]int
+}
+
+func (m *fakeManifest) Version() string {
+	return m.version
+}
+
+func (m *fakeManifest) Contracts() []string {
+	var names []string
+	for name := range m.contracts {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (m *fakeManifest) FulfillContract(definition destiny2.Contract, opts ...destiny2.FulfillmentOption) error {
+	m.fulfilled[definition.Name()]++
+	return definition.Unmarshal([]byte(m.contracts[definition.Name()]))
+}
+
+func newManifestClient(t *testing.T, source destiny2.ManifestSource) manifestpb.ManifestClient {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	manifestpb.RegisterManifestServer(srv, NewManifestServer(source))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dial), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return manifestpb.NewManifestClient(conn)
+}
+
+func TestManifestServer(t *testing.T) {
+	source := &fakeManifest{
+		version: "v1",
+		contracts: map[string]string{
+			"DestinyInventoryItemDefinition": `{
+				"347366834": {"hash": 347366834, "displayProperties": {"name": "Ace of Spades"}, "itemType": 3},
+				"3211806999": {"hash": 3211806999, "displayProperties": {"name": "Izanagi's Burden"}, "itemType": 3}
+			}`,
+			"DestinyLoreDefinition": `{"1": {"hash": 1, "displayProperties": {"name": "Ace in the Hole"}}}`,
+		},
+		fulfilled: map[string]int{},
+	}
+	client := newManifestClient(t, source)
+	ctx := context.Background()
+
+	version, err := client.GetVersion(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version.Version != "v1" {
+		t.Errorf("GetVersion: got %q, want %q", version.Version, "v1")
+	}
+
+	entity, err := client.GetEntity(ctx, &manifestpb.EntityRequest{Contract: "DestinyInventoryItemDefinition", Hash: 347366834})
+	if err != nil {
+		t.Fatal(err)
+	}
+	item := new(manifestpb.InventoryItemEntity)
+	if err := entity.Entity.UnmarshalTo(item); err != nil {
+		t.Fatal(err)
+	}
+	if got := item.DisplayProperties.GetName(); got != "Ace of Spades" {
+		t.Errorf("GetEntity(347366834): got name %q, want %q", got, "Ace of Spades")
+	}
+	if got := destiny2.InventoryItemEntityFromProto(item).ItemType; got != destiny2.Item_Weapon {
+		t.Errorf("GetEntity(347366834): got item type %v, want %v", got, destiny2.Item_Weapon)
+	}
+
+	_, err = client.GetEntity(ctx, &manifestpb.EntityRequest{Contract: "DestinyInventoryItemDefinition", Hash: 42})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetEntity(42): got error %v, want NotFound", err)
+	}
+	_, err = client.GetEntity(ctx, &manifestpb.EntityRequest{Contract: "DestinyNotADefinition", Hash: 42})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetEntity for unknown contract: got error %v, want InvalidArgument", err)
+	}
+
+	batch, err := client.BatchGetEntities(ctx, &manifestpb.BatchEntityRequest{
+		Contract: "DestinyInventoryItemDefinition",
+		Hashes:   []uint32{3211806999, 42, 347366834},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotHashes []uint32
+	for _, entity := range batch.Entities {
+		gotHashes = append(gotHashes, entity.Hash)
+	}
+	if diff := cmp.Diff([]uint32{3211806999, 347366834}, gotHashes); diff != "" {
+		t.Errorf("BatchGetEntities hashes: (-want +got)\n%s", diff)
+	}
+	if diff := cmp.Diff([]uint32{42}, batch.MissingHashes); diff != "" {
+		t.Errorf("BatchGetEntities missing hashes: (-want +got)\n%s", diff)
+	}
+
+	search, err := client.SearchEntities(ctx, &manifestpb.SearchRequest{Query: "ACE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotContracts []string
+	for _, entity := range search.Entities {
+		gotContracts = append(gotContracts, entity.Contract)
+	}
+	if len(gotContracts) != 2 {
+		t.Errorf("SearchEntities(%q): got matches in %v, want one item and one lore entry", "ACE", gotContracts)
+	}
+
+	if got := source.fulfilled["DestinyInventoryItemDefinition"]; got != 1 {
+		t.Errorf("DestinyInventoryItemDefinition fulfilled %d times, want 1", got)
+	}
+
+	source.version = "v2"
+	if _, err := client.GetEntity(ctx, &manifestpb.EntityRequest{Contract: "DestinyInventoryItemDefinition", Hash: 347366834}); err != nil {
+		t.Fatal(err)
+	}
+	if got := source.fulfilled["DestinyInventoryItemDefinition"]; got != 2 {
+		t.Errorf("DestinyInventoryItemDefinition fulfilled %d times after a new version, want 2", got)
+	}
+}
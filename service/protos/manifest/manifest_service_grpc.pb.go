@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package manifest
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ManifestClient is the client API for Manifest service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ManifestClient interface {
+	GetVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+	ListContracts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ContractsResponse, error)
+	GetEntity(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*Entity, error)
+	BatchGetEntities(ctx context.Context, in *BatchEntityRequest, opts ...grpc.CallOption) (*BatchEntityResponse, error)
+	SearchEntities(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type manifestClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManifestClient(cc grpc.ClientConnInterface) ManifestClient {
+	return &manifestClient{cc}
+}
+
+func (c *manifestClient) GetVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/manifest.Manifest/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestClient) ListContracts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ContractsResponse, error) {
+	out := new(ContractsResponse)
+	err := c.cc.Invoke(ctx, "/manifest.Manifest/ListContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestClient) GetEntity(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*Entity, error) {
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, "/manifest.Manifest/GetEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestClient) BatchGetEntities(ctx context.Context, in *BatchEntityRequest, opts ...grpc.CallOption) (*BatchEntityResponse, error) {
+	out := new(BatchEntityResponse)
+	err := c.cc.Invoke(ctx, "/manifest.Manifest/BatchGetEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestClient) SearchEntities(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/manifest.Manifest/SearchEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManifestServer is the server API for Manifest service.
+// All implementations must embed UnimplementedManifestServer
+// for forward compatibility
+type ManifestServer interface {
+	GetVersion(context.Context, *emptypb.Empty) (*VersionResponse, error)
+	ListContracts(context.Context, *emptypb.Empty) (*ContractsResponse, error)
+	GetEntity(context.Context, *EntityRequest) (*Entity, error)
+	BatchGetEntities(context.Context, *BatchEntityRequest) (*BatchEntityResponse, error)
+	SearchEntities(context.Context, *SearchRequest) (*SearchResponse, error)
+	mustEmbedUnimplementedManifestServer()
+}
+
+// UnimplementedManifestServer must be embedded to have forward compatible implementations.
+type UnimplementedManifestServer struct {
+}
+
+func (UnimplementedManifestServer) GetVersion(context.Context, *emptypb.Empty) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedManifestServer) ListContracts(context.Context, *emptypb.Empty) (*ContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContracts not implemented")
+}
+func (UnimplementedManifestServer) GetEntity(context.Context, *EntityRequest) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntity not implemented")
+}
+func (UnimplementedManifestServer) BatchGetEntities(context.Context, *BatchEntityRequest) (*BatchEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetEntities not implemented")
+}
+func (UnimplementedManifestServer) SearchEntities(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEntities not implemented")
+}
+func (UnimplementedManifestServer) mustEmbedUnimplementedManifestServer() {}
+
+// UnsafeManifestServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManifestServer will
+// result in compilation errors.
+type UnsafeManifestServer interface {
+	mustEmbedUnimplementedManifestServer()
+}
+
+func RegisterManifestServer(s grpc.ServiceRegistrar, srv ManifestServer) {
+	s.RegisterService(&Manifest_ServiceDesc, srv)
+}
+
+func _Manifest_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.Manifest/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).GetVersion(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manifest_ListContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).ListContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.Manifest/ListContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).ListContracts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manifest_GetEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).GetEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.Manifest/GetEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).GetEntity(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manifest_BatchGetEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).BatchGetEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.Manifest/BatchGetEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).BatchGetEntities(ctx, req.(*BatchEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manifest_SearchEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).SearchEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.Manifest/SearchEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).SearchEntities(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Manifest_ServiceDesc is the grpc.ServiceDesc for Manifest service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Manifest_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "manifest.Manifest",
+	HandlerType: (*ManifestServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVersion",
+			Handler:    _Manifest_GetVersion_Handler,
+		},
+		{
+			MethodName: "ListContracts",
+			Handler:    _Manifest_ListContracts_Handler,
+		},
+		{
+			MethodName: "GetEntity",
+			Handler:    _Manifest_GetEntity_Handler,
+		},
+		{
+			MethodName: "BatchGetEntities",
+			Handler:    _Manifest_BatchGetEntities_Handler,
+		},
+		{
+			MethodName: "SearchEntities",
+			Handler:    _Manifest_SearchEntities_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/manifest/manifest_service.proto",
+}
@@ -0,0 +1,147 @@
+package destiny2
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// StatGroupError represents an item whose stat group isn't in a StatCalculator's StatGroups.
+type StatGroupError struct {
+	itemHash, statGroupHash uint32
+}
+
+func (e StatGroupError) Error() string {
+	return fmt.Sprintf("stat group %d of item %d is not a known StatGroupEntity", e.statGroupHash, e.itemHash)
+}
+
+// ItemStat is a stat as displayed on an item.
+type ItemStat struct {
+	// StatHash is the hash of a related StatEntity.
+	StatHash uint32
+	// Investment is the sum of the raw investment values of the item and its plugs for this stat.
+	Investment int32
+	// Value is the value displayed for this stat.
+	Value int32
+	// Maximum is the largest value this stat can be displayed as.
+	Maximum int32
+	// DisplayAsNumeric determines if this stat should be displayed as a number rather than a bar.
+	DisplayAsNumeric bool
+}
+
+// StatCalculator calculates the stats displayed on items from their investment stats and the investment stats of
+// their inserted plugs, such as perks, masterworks and mods.
+type StatCalculator struct {
+	// StatGroups are the stat groups items refer to by Stats.StatGroupHash.
+	StatGroups StatGroupDefinition
+	// IsActive determines if a conditionally active investment stat on an item or plug applies,
+	// such as a masterwork bonus that only applies to armor of a matching energy type.
+	// If IsActive is nil, conditionally active stats never apply.
+	IsActive func(source InventoryItemEntity, stat ItemInvestmentStat) bool
+}
+
+// Investments returns the sum of the investment stats of an item and its plugs by stat hash.
+// Conditionally active stats are only included if IsActive reports they apply.
+func (c StatCalculator) Investments(item InventoryItemEntity, plugs ...InventoryItemEntity) map[uint32]int32 {
+	investments := map[uint32]int32{}
+	for _, source := range append([]InventoryItemEntity{item}, plugs...) {
+		for _, stat := range source.InvestmentStats {
+			if stat.IsConditionallyActive && (c.IsActive == nil || !c.IsActive(source, stat)) {
+				continue
+			}
+			investments[stat.StatTypeHash] += stat.Value
+		}
+	}
+	return investments
+}
+
+// Stats returns the stats displayed on an item with plugs inserted, in the order of its stat group.
+// Only stats the item has are displayed: those in its precomputed Stats, or invested in by the item or its plugs.
+// Stats scaled by the item's stat group are interpolated with their DisplayInterpolation and clamped to
+// their MaximumValue; other precomputed stats on the item are displayed as their investment value, clamped to
+// the stat group's MaximumValue. Items without a stat group have no displayed stats.
+func (c StatCalculator) Stats(item InventoryItemEntity, plugs ...InventoryItemEntity) ([]ItemStat, error) {
+	if item.Stats.StatGroupHash == 0 {
+		return nil, nil
+	}
+	group, ok := c.StatGroups[item.Stats.StatGroupHash]
+	if !ok {
+		return nil, StatGroupError{item.Hash, item.Stats.StatGroupHash}
+	}
+
+	investments := c.Investments(item, plugs...)
+	var stats []ItemStat
+	scaled := map[uint32]bool{}
+	for _, display := range group.ScaledStats {
+		scaled[display.StatHash] = true
+		_, precomputed := item.Stats.Stats[display.StatHash]
+		investment, invested := investments[display.StatHash]
+		if !precomputed && !invested {
+			continue
+		}
+		value := investment
+		if len(display.DisplayInterpolation) > 0 {
+			value = interpolateStat(investment, display.DisplayInterpolation)
+		}
+		maximum := display.MaximumValue
+		if maximum == 0 {
+			maximum = group.MaximumValue
+		}
+		stats = append(stats, ItemStat{
+			StatHash:         display.StatHash,
+			Investment:       investment,
+			Value:            clampStat(value, maximum),
+			Maximum:          maximum,
+			DisplayAsNumeric: display.DisplayAsNumeric,
+		})
+	}
+
+	var unscaled []ItemStat
+	for hash := range item.Stats.Stats {
+		if scaled[hash] {
+			continue
+		}
+		investment := investments[hash]
+		unscaled = append(unscaled, ItemStat{
+			StatHash:         hash,
+			Investment:       investment,
+			Value:            clampStat(investment, group.MaximumValue),
+			Maximum:          group.MaximumValue,
+			DisplayAsNumeric: true,
+		})
+	}
+	sort.Slice(unscaled, func(i, j int) bool { return unscaled[i].StatHash < unscaled[j].StatHash })
+	return append(stats, unscaled...), nil
+}
+
+// interpolateStat transforms an investment value into a display value with a stat's interpolation table.
+// Values outside the table are clamped to its ends and the result is rounded half to even, as the game does.
+func interpolateStat(investment int32, table []InterpolationPoint) int32 {
+	first, last := table[0], table[len(table)-1]
+	if investment <= first.Value {
+		return first.Weight
+	}
+	if investment >= last.Value {
+		return last.Weight
+	}
+
+	i := sort.Search(len(table), func(i int) bool { return table[i].Value >= investment })
+	end := table[i]
+	if end.Value == investment {
+		return end.Weight
+	}
+	start := table[i-1]
+	t := float64(investment-start.Value) / float64(end.Value-start.Value)
+	return int32(math.RoundToEven(float64(start.Weight) + t*float64(end.Weight-start.Weight)))
+}
+
+// clampStat clamps a display value between 0 and maximum, if maximum is positive.
+func clampStat(value, maximum int32) int32 {
+	if value < 0 {
+		return 0
+	}
+	if maximum > 0 && value > maximum {
+		return maximum
+	}
+	return value
+}
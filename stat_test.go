@@ -0,0 +1,126 @@
+package destiny2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInterpolateStat(t *testing.T) {
+	table := []InterpolationPoint{{Value: 0, Weight: 10}, {Value: 20, Weight: 15}, {Value: 100, Weight: 100}}
+	tests := []struct {
+		investment int32
+		want       int32
+	}{
+		{-5, 10},
+		{0, 10},
+		{4, 11},
+		// 12.5 rounds half to even.
+		{10, 12},
+		{30, 26},
+		{20, 15},
+		// 57.5 rounds half to even.
+		{60, 58},
+		{100, 100},
+		{150, 100},
+	}
+	for _, test := range tests {
+		if got := interpolateStat(test.investment, table); got != test.want {
+			t.Errorf("interpolateStat(%d): got %d, want %d", test.investment, got, test.want)
+		}
+	}
+}
+
+func TestStatCalculator(t *testing.T) {
+	const (
+		stability = 155624089
+		magazine  = 3871231066
+		rpm       = 4284893193
+		impact    = 4043523819
+	)
+	groups := StatGroupDefinition{
+		1: {
+			MaximumValue: 100,
+			ScaledStats: []StatDisplay{
+				{StatHash: stability, MaximumValue: 100, DisplayInterpolation: []InterpolationPoint{{0, 0}, {100, 100}}},
+				{StatHash: magazine, MaximumValue: 0, DisplayAsNumeric: true, DisplayInterpolation: []InterpolationPoint{{0, 8}, {100, 20}}},
+				{StatHash: rpm, MaximumValue: 1000, DisplayAsNumeric: true},
+			},
+		},
+	}
+
+	weapon := InventoryItemEntity{
+		Stats: ItemStatBlock{
+			StatGroupHash: 1,
+			Stats:         map[uint32]InventoryItemStat{stability: {}, rpm: {}, impact: {}},
+		},
+		// The weapon has no magazine stat unless a plug invests in it.
+		InvestmentStats: []ItemInvestmentStat{
+			{StatTypeHash: stability, Value: 45},
+			{StatTypeHash: impact, Value: 80},
+		},
+	}
+	barrel := InventoryItemEntity{InvestmentStats: []ItemInvestmentStat{{StatTypeHash: stability, Value: 10}, {StatTypeHash: magazine, Value: -60}}}
+	masterwork := InventoryItemEntity{
+		EntityMetadata:  EntityMetadata{Hash: 7},
+		InvestmentStats: []ItemInvestmentStat{{StatTypeHash: stability, Value: 60, IsConditionallyActive: true}, {StatTypeHash: impact, Value: 30}},
+	}
+
+	tests := []struct {
+		name     string
+		plugs    []InventoryItemEntity
+		isActive func(InventoryItemEntity, ItemInvestmentStat) bool
+		want     []ItemStat
+	}{
+		{
+			name: "no plugs",
+			want: []ItemStat{
+				{StatHash: stability, Investment: 45, Value: 45, Maximum: 100},
+				{StatHash: rpm, Investment: 0, Value: 0, Maximum: 1000, DisplayAsNumeric: true},
+				{StatHash: impact, Investment: 80, Value: 80, Maximum: 100, DisplayAsNumeric: true},
+			},
+		},
+		{
+			name:  "inactive conditional stat",
+			plugs: []InventoryItemEntity{barrel, masterwork},
+			want: []ItemStat{
+				{StatHash: stability, Investment: 55, Value: 55, Maximum: 100},
+				{StatHash: magazine, Investment: -60, Value: 8, Maximum: 100, DisplayAsNumeric: true},
+				{StatHash: rpm, Investment: 0, Value: 0, Maximum: 1000, DisplayAsNumeric: true},
+				{StatHash: impact, Investment: 110, Value: 100, Maximum: 100, DisplayAsNumeric: true},
+			},
+		},
+		{
+			name:     "active conditional stat",
+			plugs:    []InventoryItemEntity{barrel, masterwork},
+			isActive: func(source InventoryItemEntity, stat ItemInvestmentStat) bool { return source.Hash == 7 },
+			want: []ItemStat{
+				{StatHash: stability, Investment: 115, Value: 100, Maximum: 100},
+				{StatHash: magazine, Investment: -60, Value: 8, Maximum: 100, DisplayAsNumeric: true},
+				{StatHash: rpm, Investment: 0, Value: 0, Maximum: 1000, DisplayAsNumeric: true},
+				{StatHash: impact, Investment: 110, Value: 100, Maximum: 100, DisplayAsNumeric: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calc := StatCalculator{StatGroups: groups, IsActive: test.isActive}
+			got, err := calc.Stats(weapon, test.plugs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Stats() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := (StatCalculator{}).Stats(weapon); !errors.As(err, &StatGroupError{}) {
+		t.Errorf("Stats() with unknown stat group: got error %v, want StatGroupError", err)
+	}
+	if got, err := (StatCalculator{}).Stats(InventoryItemEntity{}); got != nil || err != nil {
+		t.Errorf("Stats() without stat group: got %v, %v, want no stats", got, err)
+	}
+}
@@ -0,0 +1,254 @@
+package destiny2
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// Tooltip is the information shown when inspecting an item or plug.
+type Tooltip struct {
+	// Name is the localized name of the item.
+	Name string
+	// TypeLine is the localized type and tier of the item, such as "Legendary Auto Rifle".
+	TypeLine string
+	// Description is the localized description of the item.
+	Description string
+	// FlavorText is the localized quote or lore of the item.
+	FlavorText string
+	// Perks are the displayable perks granted by the item.
+	Perks []TooltipPerk
+	// Stats are the stats changed by the item.
+	Stats []StatDelta
+	// Notifications are the tooltip notifications of the item, such as "Can only be inserted into exotic armor."
+	Notifications []string
+}
+
+// TooltipPerk is a perk shown in a tooltip.
+type TooltipPerk struct {
+	// PerkHash is the hash of a related SandboxPerkEntity.
+	PerkHash uint32
+	// Name is the localized name of the perk.
+	Name string
+	// Description is the localized description of the perk.
+	Description string
+	// Requirement is the localized requirement for an inactive perk to become active, if any.
+	Requirement string
+}
+
+// StatDelta is a change in a stat shown in a tooltip.
+type StatDelta struct {
+	// StatHash is the hash of a related StatEntity.
+	StatHash uint32
+	// Name is the localized name of the stat.
+	Name string
+	// Delta is the change in the stat.
+	Delta int32
+}
+
+// TooltipBuilder builds the tooltips of items and plugs.
+type TooltipBuilder struct {
+	// Perks are the sandbox perks items refer to by ItemPerkEntry.PerkHash.
+	Perks SandboxPerkDefinition
+	// Stats name the stats changed by items.
+	Stats StatDefinition
+	// Calculator calculates displayed stat changes for BuildPlug.
+	Calculator StatCalculator
+}
+
+// Build returns the tooltip of an item. Its stat changes are its investment stats, which is what a plug adds to
+// the item it is inserted into before interpolation; use BuildPlug for the displayed change on a specific item.
+// Perks that aren't displayable or are hidden are skipped, as are perks missing from Perks.
+func (b TooltipBuilder) Build(item InventoryItemEntity) Tooltip {
+	tooltip := Tooltip{
+		Name:        item.DisplayProperties.Name,
+		TypeLine:    item.ItemTypeAndTierDisplayName,
+		Description: item.DisplayProperties.Description,
+		FlavorText:  item.FlavorText,
+	}
+	if tooltip.TypeLine == "" {
+		tooltip.TypeLine = item.ItemTypeDisplayName
+	}
+
+	for _, entry := range item.Perks {
+		perk, ok := b.Perks[entry.PerkHash]
+		if !ok || !perk.IsDisplayable || entry.PerkVisibility == ItemPerk_Hidden {
+			continue
+		}
+		tooltip.Perks = append(tooltip.Perks, TooltipPerk{
+			PerkHash:    entry.PerkHash,
+			Name:        perk.DisplayProperties.Name,
+			Description: perk.DisplayProperties.Description,
+			Requirement: entry.RequirementDisplayString,
+		})
+	}
+
+	deltas := map[uint32]int32{}
+	for _, stat := range item.InvestmentStats {
+		deltas[stat.StatTypeHash] += stat.Value
+	}
+	tooltip.Stats = b.statDeltas(deltas)
+
+	for _, notification := range item.TooltipNotifications {
+		if notification.DisplayString != "" {
+			tooltip.Notifications = append(tooltip.Notifications, notification.DisplayString)
+		}
+	}
+	return tooltip
+}
+
+// BuildPlug returns the tooltip of a plug with its stat changes being the change in the stats displayed on parent
+// when the plug is inserted, using Calculator.
+func (b TooltipBuilder) BuildPlug(parent, plug InventoryItemEntity) (Tooltip, error) {
+	tooltip := b.Build(plug)
+	before, err := b.Calculator.Stats(parent)
+	if err != nil {
+		return Tooltip{}, err
+	}
+	after, err := b.Calculator.Stats(parent, plug)
+	if err != nil {
+		return Tooltip{}, err
+	}
+
+	// A plug can add stats the parent doesn't display, so stats are matched by hash rather than position.
+	deltas := map[uint32]int32{}
+	for _, stat := range after {
+		deltas[stat.StatHash] = stat.Value
+	}
+	for _, stat := range before {
+		deltas[stat.StatHash] -= stat.Value
+	}
+	tooltip.Stats = b.statDeltas(deltas)
+	return tooltip, nil
+}
+
+// statDeltas returns the non-zero deltas, ordered by stat hash.
+func (b TooltipBuilder) statDeltas(deltas map[uint32]int32) []StatDelta {
+	var stats []StatDelta
+	for hash, delta := range deltas {
+		if delta == 0 {
+			continue
+		}
+		name := b.Stats[hash].DisplayProperties.Name
+		if name == "" {
+			name = fmt.Sprintf("Stat %d", hash)
+		}
+		stats = append(stats, StatDelta{StatHash: hash, Name: name, Delta: delta})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].StatHash < stats[j].StatHash })
+	return stats
+}
+
+// Text renders the tooltip as plain text.
+func (t Tooltip) Text() string {
+	var b strings.Builder
+	b.WriteString(t.Name + "\n")
+	if t.TypeLine != "" {
+		b.WriteString(t.TypeLine + "\n")
+	}
+	if t.Description != "" {
+		b.WriteString("\n" + t.Description + "\n")
+	}
+	for _, perk := range t.Perks {
+		fmt.Fprintf(&b, "\n%s: %s\n", perk.Name, perk.Description)
+		if perk.Requirement != "" {
+			fmt.Fprintf(&b, "  %s\n", perk.Requirement)
+		}
+	}
+	if len(t.Stats) > 0 {
+		b.WriteString("\n")
+		for _, stat := range t.Stats {
+			fmt.Fprintf(&b, "%+d %s\n", stat.Delta, stat.Name)
+		}
+	}
+	for _, notification := range t.Notifications {
+		fmt.Fprintf(&b, "\n%s\n", notification)
+	}
+	if t.FlavorText != "" {
+		fmt.Fprintf(&b, "\n\"%s\"\n", t.FlavorText)
+	}
+	return b.String()
+}
+
+// Markdown renders the tooltip as Markdown.
+func (t Tooltip) Markdown() string {
+	escape := strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "#", `\#`, "[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`).Replace
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "### %s\n", escape(t.Name))
+	if t.TypeLine != "" {
+		fmt.Fprintf(&b, "\n*%s*\n", escape(t.TypeLine))
+	}
+	if t.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", escape(t.Description))
+	}
+	if len(t.Perks) > 0 {
+		b.WriteString("\n")
+		for _, perk := range t.Perks {
+			fmt.Fprintf(&b, "- **%s**: %s", escape(perk.Name), escape(perk.Description))
+			if perk.Requirement != "" {
+				fmt.Fprintf(&b, " *(%s)*", escape(perk.Requirement))
+			}
+			b.WriteString("\n")
+		}
+	}
+	if len(t.Stats) > 0 {
+		b.WriteString("\n")
+		for _, stat := range t.Stats {
+			fmt.Fprintf(&b, "- %+d %s\n", stat.Delta, escape(stat.Name))
+		}
+	}
+	for _, notification := range t.Notifications {
+		fmt.Fprintf(&b, "\n> %s\n", escape(notification))
+	}
+	if t.FlavorText != "" {
+		fmt.Fprintf(&b, "\n*\"%s\"*\n", escape(t.FlavorText))
+	}
+	return b.String()
+}
+
+// HTML renders the tooltip as an HTML fragment, with classes for styling.
+func (t Tooltip) HTML() string {
+	escape := html.EscapeString
+
+	var b strings.Builder
+	b.WriteString(`<div class="tooltip">` + "\n")
+	fmt.Fprintf(&b, "<h3 class=\"tooltip-name\">%s</h3>\n", escape(t.Name))
+	if t.TypeLine != "" {
+		fmt.Fprintf(&b, "<p class=\"tooltip-type\">%s</p>\n", escape(t.TypeLine))
+	}
+	if t.Description != "" {
+		fmt.Fprintf(&b, "<p class=\"tooltip-description\">%s</p>\n", escape(t.Description))
+	}
+	if len(t.Perks) > 0 {
+		b.WriteString(`<ul class="tooltip-perks">` + "\n")
+		for _, perk := range t.Perks {
+			fmt.Fprintf(&b, "<li><strong>%s</strong>: %s", escape(perk.Name), escape(perk.Description))
+			if perk.Requirement != "" {
+				fmt.Fprintf(&b, " <em>%s</em>", escape(perk.Requirement))
+			}
+			b.WriteString("</li>\n")
+		}
+		b.WriteString("</ul>\n")
+	}
+	if len(t.Stats) > 0 {
+		b.WriteString(`<ul class="tooltip-stats">` + "\n")
+		for _, stat := range t.Stats {
+			class := "positive"
+			if stat.Delta < 0 {
+				class = "negative"
+			}
+			fmt.Fprintf(&b, "<li class=\"%s\">%+d %s</li>\n", class, stat.Delta, escape(stat.Name))
+		}
+		b.WriteString("</ul>\n")
+	}
+	for _, notification := range t.Notifications {
+		fmt.Fprintf(&b, "<p class=\"tooltip-notification\">%s</p>\n", escape(notification))
+	}
+	if t.FlavorText != "" {
+		fmt.Fprintf(&b, "<blockquote class=\"tooltip-flavor\">%s</blockquote>\n", escape(t.FlavorText))
+	}
+	b.WriteString("</div>\n")
+	return b.String()
+}
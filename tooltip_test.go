@@ -0,0 +1,139 @@
+package destiny2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTooltipBuilder(t *testing.T) {
+	const (
+		stability = 155624089
+		handling  = 943549884
+		reach     = 1240592695
+	)
+	builder := TooltipBuilder{
+		Perks: SandboxPerkDefinition{
+			1: {DisplayProperties: DisplayProperties{Name: "Outlaw", Description: "Precision kills reload faster."}, IsDisplayable: true},
+			2: {DisplayProperties: DisplayProperties{Name: "Internal"}},
+			3: {DisplayProperties: DisplayProperties{Name: "Secret"}, IsDisplayable: true},
+		},
+		Stats: StatDefinition{
+			stability: {DisplayProperties: DisplayProperties{Name: "Stability"}},
+			handling:  {DisplayProperties: DisplayProperties{Name: "Handling"}},
+			reach:     {DisplayProperties: DisplayProperties{Name: "Range"}},
+		},
+		Calculator: StatCalculator{StatGroups: StatGroupDefinition{
+			1: {
+				MaximumValue: 100,
+				ScaledStats: []StatDisplay{
+					{StatHash: reach, MaximumValue: 100},
+					{StatHash: stability, MaximumValue: 100, DisplayInterpolation: []InterpolationPoint{{0, 0}, {100, 50}}},
+					{StatHash: handling, MaximumValue: 100, DisplayInterpolation: []InterpolationPoint{{0, 0}, {100, 100}}},
+				},
+			},
+		}},
+	}
+
+	plug := InventoryItemEntity{
+		DisplayProperties:          DisplayProperties{Name: "Outlaw", Description: "Reload faster."},
+		ItemTypeDisplayName:        "Trait",
+		ItemTypeAndTierDisplayName: "Enhanced Trait",
+		Perks: []ItemPerkEntry{
+			{PerkHash: 1, RequirementDisplayString: "Requires a precision kill."},
+			{PerkHash: 2},
+			{PerkHash: 3, PerkVisibility: ItemPerk_Hidden},
+			{PerkHash: 4},
+		},
+		InvestmentStats: []ItemInvestmentStat{
+			{StatTypeHash: stability, Value: 10},
+			{StatTypeHash: handling, Value: -5},
+			{StatTypeHash: reach, Value: 8},
+			{StatTypeHash: 99, Value: 0},
+		},
+		TooltipNotifications: []ItemTooltipNotification{{DisplayString: "Can only be inserted into weapons."}, {}},
+	}
+
+	want := Tooltip{
+		Name:        "Outlaw",
+		TypeLine:    "Enhanced Trait",
+		Description: "Reload faster.",
+		Perks:       []TooltipPerk{{PerkHash: 1, Name: "Outlaw", Description: "Precision kills reload faster.", Requirement: "Requires a precision kill."}},
+		Stats: []StatDelta{
+			{StatHash: stability, Name: "Stability", Delta: 10},
+			{StatHash: handling, Name: "Handling", Delta: -5},
+			{StatHash: reach, Name: "Range", Delta: 8},
+		},
+		Notifications: []string{"Can only be inserted into weapons."},
+	}
+	if diff := cmp.Diff(want, builder.Build(plug)); diff != "" {
+		t.Errorf("Build() (-want +got):\n%s", diff)
+	}
+
+	// Inserted into a weapon, stability is interpolated to half of its investment and range, which the weapon
+	// doesn't have, is displayed ahead of the weapon's own stats.
+	weapon := InventoryItemEntity{
+		Stats:           ItemStatBlock{StatGroupHash: 1},
+		InvestmentStats: []ItemInvestmentStat{{StatTypeHash: stability, Value: 40}, {StatTypeHash: handling, Value: 40}},
+	}
+	want.Stats = []StatDelta{
+		{StatHash: stability, Name: "Stability", Delta: 5},
+		{StatHash: handling, Name: "Handling", Delta: -5},
+		{StatHash: reach, Name: "Range", Delta: 8},
+	}
+	got, err := builder.BuildPlug(weapon, plug)
+	if err != nil {
+		t.Fatalf("BuildPlug() = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("BuildPlug() (-want +got):\n%s", diff)
+	}
+}
+
+func TestTooltip_Render(t *testing.T) {
+	tooltip := Tooltip{
+		Name:          "Rose",
+		TypeLine:      "Hand Cannon",
+		Description:   "Stay <calm> & *focused*.",
+		Perks:         []TooltipPerk{{Name: "Opening Shot", Description: "Improved accuracy.", Requirement: "Out of combat."}},
+		Stats:         []StatDelta{{Name: "Range", Delta: 5}, {Name: "Handling", Delta: -10}},
+		Notifications: []string{"Crucible reward."},
+		FlavorText:    "A thorn.",
+	}
+
+	tests := []struct {
+		name   string
+		render func() string
+		want   string
+	}{
+		{
+			name:   "text",
+			render: tooltip.Text,
+			want: "Rose\nHand Cannon\n\nStay <calm> & *focused*.\n\nOpening Shot: Improved accuracy.\n  Out of combat.\n\n" +
+				"+5 Range\n-10 Handling\n\nCrucible reward.\n\n\"A thorn.\"\n",
+		},
+		{
+			name:   "markdown",
+			render: tooltip.Markdown,
+			want: "### Rose\n\n*Hand Cannon*\n\nStay \\<calm\\> & \\*focused\\*.\n\n- **Opening Shot**: Improved accuracy. *(Out of combat.)*\n\n" +
+				"- +5 Range\n- -10 Handling\n\n> Crucible reward.\n\n*\"A thorn.\"*\n",
+		},
+		{
+			name:   "html",
+			render: tooltip.HTML,
+			want: "<div class=\"tooltip\">\n<h3 class=\"tooltip-name\">Rose</h3>\n<p class=\"tooltip-type\">Hand Cannon</p>\n" +
+				"<p class=\"tooltip-description\">Stay &lt;calm&gt; &amp; *focused*.</p>\n" +
+				"<ul class=\"tooltip-perks\">\n<li><strong>Opening Shot</strong>: Improved accuracy. <em>Out of combat.</em></li>\n</ul>\n" +
+				"<ul class=\"tooltip-stats\">\n<li class=\"positive\">+5 Range</li>\n<li class=\"negative\">-10 Handling</li>\n</ul>\n" +
+				"<p class=\"tooltip-notification\">Crucible reward.</p>\n<blockquote class=\"tooltip-flavor\">A thorn.</blockquote>\n</div>\n",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.render()); diff != "" {
+				t.Errorf("render (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package destiny2
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// variablePattern matches a string variable placeholder such as {var:1234}.
+var variablePattern = regexp.MustCompile(`\{var:(\d+)\}`)
+
+// StringVariables returns the hashes of the string variables a description refers to, in order of appearance.
+// A variable that appears more than once is returned once.
+func StringVariables(description string) []uint32 {
+	var hashes []uint32
+	seen := map[uint32]bool{}
+	for _, match := range variablePattern.FindAllStringSubmatch(description, -1) {
+		hash, err := strconv.ParseUint(match[1], 10, 32)
+		if err != nil || seen[uint32(hash)] {
+			continue
+		}
+		seen[uint32(hash)] = true
+		hashes = append(hashes, uint32(hash))
+	}
+	return hashes
+}
+
+// VariableFormatter fills the {var:hash} placeholders in localized text, such as DisplayProperties.Description or
+// ObjectiveEntity.ProgressDescription, with the values of a profile's string variables.
+type VariableFormatter struct {
+	// Global are the values of string variables shared by every character in the profile, by variable hash.
+	Global map[uint32]int32
+	// Characters are the values of string variables specific to a character, by character ID and variable hash.
+	// A character's value takes precedence over a global value of the same variable.
+	Characters map[int64]map[uint32]int32
+	// Fallback returns the text substituted for a variable with no value.
+	// If Fallback is nil, the placeholder is left as is.
+	Fallback func(hash uint32) string
+}
+
+// Format returns text with its placeholders filled with global values.
+func (f VariableFormatter) Format(text string) string {
+	return f.format(text, nil)
+}
+
+// FormatCharacter returns text with its placeholders filled with the values of the character with characterID,
+// or global values for variables the character doesn't have.
+func (f VariableFormatter) FormatCharacter(characterID int64, text string) string {
+	return f.format(text, f.Characters[characterID])
+}
+
+func (f VariableFormatter) format(text string, character map[uint32]int32) string {
+	return variablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		hash, err := strconv.ParseUint(variablePattern.FindStringSubmatch(placeholder)[1], 10, 32)
+		if err != nil {
+			return placeholder
+		}
+		if value, ok := character[uint32(hash)]; ok {
+			return strconv.FormatInt(int64(value), 10)
+		}
+		if value, ok := f.Global[uint32(hash)]; ok {
+			return strconv.FormatInt(int64(value), 10)
+		}
+		if f.Fallback != nil {
+			return f.Fallback(uint32(hash))
+		}
+		return placeholder
+	})
+}
@@ -0,0 +1,53 @@
+package destiny2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStringVariables(t *testing.T) {
+	got := StringVariables("Defeat {var:12} of {var:34} Guardians ({var:12}). {var:99999999999} {var:} {var:x}")
+	if diff := cmp.Diff([]uint32{12, 34}, got); diff != "" {
+		t.Errorf("StringVariables() (-want +got):\n%s", diff)
+	}
+}
+
+func TestVariableFormatter(t *testing.T) {
+	const character = 2305843009300000000
+	formatter := VariableFormatter{
+		Global:     map[uint32]int32{1: 100, 2: -5},
+		Characters: map[int64]map[uint32]int32{character: {2: 7, 3: 42}},
+	}
+	fallback := formatter
+	fallback.Fallback = func(uint32) string { return "?" }
+
+	tests := []struct {
+		name      string
+		formatter VariableFormatter
+		character int64
+		text      string
+		want      string
+	}{
+		{name: "no placeholders", formatter: formatter, text: "Just text.", want: "Just text."},
+		{name: "global", formatter: formatter, text: "Earned {var:1} of {var:2}.", want: "Earned 100 of -5."},
+		{name: "unknown kept", formatter: formatter, text: "Need {var:3} more.", want: "Need {var:3} more."},
+		{name: "unknown fallback", formatter: fallback, text: "Need {var:3} more.", want: "Need ? more."},
+		{name: "character", formatter: formatter, character: character, text: "{var:1} {var:2} {var:3}", want: "100 7 42"},
+		{name: "unknown character", formatter: fallback, character: 1, text: "{var:1} {var:3}", want: "100 ?"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var got string
+			if test.character != 0 {
+				got = test.formatter.FormatCharacter(test.character, test.text)
+			} else {
+				got = test.formatter.Format(test.text)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package destiny2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// ErrNoPreviousVersion is returned by Manifest.Rollback when there is no earlier version to roll back to.
+var ErrNoPreviousVersion = errors.New("no previous manifest version to roll back to")
+
+// VersionError represents a manifest version that isn't in a VersionStore.
+type VersionError struct {
+	version string
+}
+
+func (e VersionError) Error() string {
+	return fmt.Sprintf("manifest version %q is not in the version store", e.version)
+}
+
+// VersionStore persists every manifest version a Manifest has been updated to, along with the pinned version,
+// so a pinned or rolled back Manifest keeps the same content across restarts.
+// Contracts for old versions are only available while their paths are readable, so a VersionStore is best
+// combined with a local cache of contracts such as a BinaryCacheReader.
+type VersionStore struct {
+	// Dir is the directory containing stored versions.
+	Dir string
+}
+
+// versionState is the persisted pin and history of a VersionStore.
+type versionState struct {
+	// Pinned is the version updates are refused past, if any.
+	Pinned string
+	// History is every version the manifest has used, oldest first.
+	History []string
+}
+
+func (s VersionStore) stateFile() string {
+	return filepath.Join(s.Dir, "state.json")
+}
+
+func (s VersionStore) versionFile(version string) string {
+	return filepath.Join(s.Dir, "versions", fileSafeVersion(version)+".json")
+}
+
+// load returns the persisted state, which is empty if nothing has been stored yet.
+func (s VersionStore) load() (versionState, error) {
+	var state versionState
+	data, err := ioutil.ReadFile(s.stateFile())
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+	err = json.Unmarshal(data, &state)
+	return state, err
+}
+
+func (s VersionStore) save(state versionState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(s.stateFile(), writeBytes(data))
+}
+
+// readVersion returns the DestinyManifest response stored for version.
+func (s VersionStore) readVersion(version string) ([]byte, error) {
+	data, err := ioutil.ReadFile(s.versionFile(version))
+	if os.IsNotExist(err) {
+		return nil, VersionError{version}
+	}
+	return data, err
+}
+
+// Versions returns every stored version, oldest first.
+func (s VersionStore) Versions() ([]string, error) {
+	state, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return state.History, nil
+}
+
+// NewManifestFromStore returns a Destiny 2 Manifest that records its versions in store.
+// If store has a pinned version, that version is used without contacting Bungie.net.
+// Otherwise the manifest is updated, falling back to the newest stored version if the update fails.
+func NewManifestFromStore(reader ContractReader, store VersionStore) (*Manifest, error) {
+	state, err := store.load()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{contractReader: reader, store: &store, pinned: state.Pinned}
+	if state.Pinned != "" {
+		if err := m.loadVersion(state.Pinned); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	updateErr := m.Update(nil)
+	if updateErr == nil {
+		return m, nil
+	}
+	if len(state.History) == 0 {
+		return nil, updateErr
+	}
+	if err := m.loadVersion(state.History[len(state.History)-1]); err != nil {
+		return nil, updateErr
+	}
+	return m, nil
+}
+
+// NewManifestAtVersion returns a Destiny 2 Manifest at a version from store without contacting Bungie.net,
+// such as to use the manifest offline or compare versions. If version is "", the pinned version is used if there is one,
+// otherwise the newest stored version. Unlike Pin, the version isn't pinned.
+func NewManifestAtVersion(reader ContractReader, store VersionStore, version string) (*Manifest, error) {
+	state, err := store.load()
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = state.Pinned
+	}
+	if version == "" && len(state.History) > 0 {
+		version = state.History[len(state.History)-1]
+	}
+	if version == "" {
+		return nil, VersionError{version}
+	}
+
+	m := &Manifest{contractReader: reader, store: &store, pinned: state.Pinned}
+	if err := m.loadVersion(version); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// loadVersion switches the manifest to a version from its store.
+func (m *Manifest) loadVersion(version string) error {
+	if m.store == nil {
+		return errors.New("manifest has no version store")
+	}
+	data, err := m.store.readVersion(version)
+	if err != nil {
+		return err
+	}
+	return m.parseManifest(data, nil)
+}
+
+// recordVersion stores the DestinyManifest response for the manifest's current version and appends it to the history.
+func (m *Manifest) recordVersion(data []byte) error {
+	if m.store == nil {
+		return nil
+	}
+	state, err := m.store.load()
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(m.store.versionFile(m.version), writeBytes(data)); err != nil {
+		return err
+	}
+	if n := len(state.History); n == 0 || state.History[n-1] != m.version {
+		state.History = append(state.History, m.version)
+	}
+	return m.store.save(state)
+}
+
+// Pinned returns the version the manifest is pinned to, or "" if it isn't pinned.
+func (m Manifest) Pinned() string {
+	return m.pinned
+}
+
+// Pin switches the manifest to a stored version and refuses updates past it until Unpin is called.
+func (m *Manifest) Pin(version string) error {
+	if err := m.loadVersion(version); err != nil {
+		return err
+	}
+	return m.setPinned(version)
+}
+
+// Unpin allows the manifest to be updated again.
+func (m *Manifest) Unpin() error {
+	return m.setPinned("")
+}
+
+// Rollback switches the manifest to the version used before its current version and pins it,
+// so a broken version isn't reapplied by the next update.
+func (m *Manifest) Rollback() error {
+	if m.store == nil {
+		return ErrNoPreviousVersion
+	}
+	history, err := m.store.Versions()
+	if err != nil {
+		return err
+	}
+
+	for i := len(history) - 1; i > 0; i-- {
+		if history[i] == m.version {
+			return m.Pin(history[i-1])
+		}
+	}
+	return ErrNoPreviousVersion
+}
+
+func (m *Manifest) setPinned(version string) error {
+	if m.store == nil {
+		m.pinned = version
+		return nil
+	}
+	state, err := m.store.load()
+	if err != nil {
+		return err
+	}
+	state.Pinned = version
+	if err := m.store.save(state); err != nil {
+		return err
+	}
+	m.pinned = version
+	return nil
+}
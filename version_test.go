@@ -0,0 +1,177 @@
+package destiny2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/text/language"
+)
+
+// serveManifestVersion serves a DestinyManifest response for whatever *version is when requested.
+// An empty version serves a maintenance error instead.
+func serveManifestVersion(t *testing.T, version *string) {
+	serveBungie(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if *version == "" {
+			fmt.Fprint(w, `{"ErrorCode": 5, "ErrorStatus": "SystemDisabled", "Message": "Maintenance"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"Response": {"version": %q, "mobileWorldContentPaths": {"en": "/mobile/%[1]s.content"}, "jsonWorldComponentContentPaths": {"en": {"DestinyLoreDefinition": "/lore-%[1]s.json"}}}, "ErrorCode": 1}`, *version)
+	}))
+}
+
+func TestManifestPinAndRollback(t *testing.T) {
+	version := "v1"
+	serveManifestVersion(t, &version)
+	store := VersionStore{Dir: t.TempDir()}
+
+	manifest, err := NewManifestFromStore(nil, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version = "v2"
+	if err := manifest.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := manifest.Version(); got != "v2" {
+		t.Fatalf("Version() after update: got %q, want %q", got, "v2")
+	}
+
+	if err := manifest.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := manifest.Version(), "v1"; got != want {
+		t.Errorf("Version() after Rollback: got %q, want %q", got, want)
+	}
+	if got, want := manifest.contracts[language.English]["DestinyLoreDefinition"], "/lore-v1.json"; got != want {
+		t.Errorf("contract path after Rollback: got %q, want %q", got, want)
+	}
+	if err := manifest.Rollback(); !errors.Is(err, ErrNoPreviousVersion) {
+		t.Errorf("Rollback of oldest version: got error %v, want ErrNoPreviousVersion", err)
+	}
+
+	version = "v3"
+	if err := manifest.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := manifest.Version(), "v1"; got != want {
+		t.Errorf("Version() after update while pinned: got %q, want %q", got, want)
+	}
+
+	// A restart keeps the pinned version without contacting Bungie.net.
+	version = ""
+	restarted, err := NewManifestFromStore(nil, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := restarted.Version(), "v1"; got != want {
+		t.Errorf("Version() after restart: got %q, want %q", got, want)
+	}
+	if got, want := restarted.Pinned(), "v1"; got != want {
+		t.Errorf("Pinned() after restart: got %q, want %q", got, want)
+	}
+
+	version = "v3"
+	if err := restarted.Unpin(); err != nil {
+		t.Fatal(err)
+	}
+	if err := restarted.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := restarted.Version(), "v3"; got != want {
+		t.Errorf("Version() after Unpin and update: got %q, want %q", got, want)
+	}
+
+	history, err := store.Versions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"v1", "v2", "v3"}, history); diff != "" {
+		t.Errorf("Versions(): (-want +got)\n%s", diff)
+	}
+
+	if err := restarted.Pin("v9"); !errors.As(err, &VersionError{}) {
+		t.Errorf("Pin of unknown version: got error %v, want VersionError", err)
+	}
+}
+
+func TestNewManifestFromStore_Outage(t *testing.T) {
+	version := "v1"
+	serveManifestVersion(t, &version)
+	store := VersionStore{Dir: t.TempDir()}
+	if _, err := NewManifestFromStore(nil, store); err != nil {
+		t.Fatal(err)
+	}
+
+	version = ""
+	manifest, err := NewManifestFromStore(nil, store)
+	if err != nil {
+		t.Fatalf("NewManifestFromStore during maintenance: %v", err)
+	}
+	if got, want := manifest.Version(), "v1"; got != want {
+		t.Errorf("Version() during maintenance: got %q, want %q", got, want)
+	}
+
+	if _, err := NewManifestFromStore(nil, VersionStore{Dir: t.TempDir()}); !errors.Is(err, ErrMaintenance) {
+		t.Errorf("NewManifestFromStore with empty store during maintenance: got error %v, want ErrMaintenance", err)
+	}
+}
+
+func TestNewManifestAtVersion(t *testing.T) {
+	version := "v1"
+	serveManifestVersion(t, &version)
+	store := VersionStore{Dir: t.TempDir()}
+
+	if _, err := NewManifestAtVersion(nil, store, ""); !errors.As(err, &VersionError{}) {
+		t.Errorf("NewManifestAtVersion with empty store: got error %v, want VersionError", err)
+	}
+
+	manifest, err := NewManifestFromStore(nil, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version = "v2"
+	if err := manifest.Update(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Bungie.net is never contacted, so maintenance doesn't matter.
+	version = ""
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"", "v2"},
+		{"v1", "v1"},
+		{"v2", "v2"},
+	}
+	for _, test := range tests {
+		m, err := NewManifestAtVersion(nil, store, test.version)
+		if err != nil {
+			t.Fatalf("NewManifestAtVersion(%q): %v", test.version, err)
+		}
+		if got := m.Version(); got != test.want {
+			t.Errorf("NewManifestAtVersion(%q).Version(): got %q, want %q", test.version, got, test.want)
+		}
+		if got := m.Pinned(); got != "" {
+			t.Errorf("NewManifestAtVersion(%q).Pinned(): got %q, want none", test.version, got)
+		}
+	}
+
+	if err := manifest.Pin("v1"); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewManifestAtVersion(nil, store, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := m.Version(), "v1"; got != want {
+		t.Errorf("NewManifestAtVersion while pinned: got %q, want %q", got, want)
+	}
+	if _, err := NewManifestAtVersion(nil, store, "v9"); !errors.As(err, &VersionError{}) {
+		t.Errorf("NewManifestAtVersion of unknown version: got error %v, want VersionError", err)
+	}
+}
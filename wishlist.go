@@ -0,0 +1,252 @@
+package destiny2
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// wishListWildcard is the item hash DIM wish lists use for entries that match any item.
+const wishListWildcard = -69420
+
+// WishListError represents a malformed line in a wish list.
+type WishListError struct {
+	line int
+	msg  string
+}
+
+func (e WishListError) Error() string {
+	return fmt.Sprintf("wish list line %d: %s", e.line, e.msg)
+}
+
+// WishList is a list of wanted and unwanted rolls in the DIM wish list format.
+// Each entry is a line such as
+//
+//	dimwishlist:item=1234&perks=5678,9012#notes:PvE god roll
+//
+// where a negative item hash marks an unwanted roll for the trash list, and item=-69420 matches any item.
+// A line starting with //notes: sets the notes of the following entries until the next blank line,
+// and title: and description: lines describe the list itself. Other lines, such as comments, are ignored.
+type WishList struct {
+	Title       string
+	Description string
+	Entries     []WishListEntry
+}
+
+// WishListEntry is a single roll in a wish list.
+type WishListEntry struct {
+	// Line is the line number of this entry in the wish list, starting at 1.
+	Line int
+	// ItemHash is the hash of a related InventoryItemEntity, or 0 if this entry matches any item.
+	ItemHash uint32
+	// PerkHashes are the hashes of related InventoryItemEntity plugs that a roll must have to match this entry.
+	PerkHashes []uint32
+	// Notes describe why this roll is wanted or unwanted.
+	Notes string
+	// Trash determines if this roll is unwanted.
+	Trash bool
+}
+
+// ParseWishList parses a wish list in the DIM wish list format.
+func ParseWishList(r io.Reader) (*WishList, error) {
+	list := new(WishList)
+	var blockNotes string
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "":
+			blockNotes = ""
+		case strings.HasPrefix(text, "//notes:"):
+			blockNotes = strings.TrimSpace(strings.TrimPrefix(text, "//notes:"))
+		case strings.HasPrefix(text, "title:") && list.Title == "":
+			list.Title = strings.TrimSpace(strings.TrimPrefix(text, "title:"))
+		case strings.HasPrefix(text, "description:") && list.Description == "":
+			list.Description = strings.TrimSpace(strings.TrimPrefix(text, "description:"))
+		case strings.HasPrefix(text, "dimwishlist:"):
+			entry, err := parseWishListEntry(strings.TrimPrefix(text, "dimwishlist:"))
+			if err != nil {
+				return nil, WishListError{line, err.Error()}
+			}
+			entry.Line = line
+			if entry.Notes == "" {
+				entry.Notes = blockNotes
+			}
+			list.Entries = append(list.Entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// parseWishListEntry parses the part of an entry after "dimwishlist:".
+func parseWishListEntry(text string) (WishListEntry, error) {
+	var entry WishListEntry
+	if i := strings.Index(text, "#notes:"); i >= 0 {
+		entry.Notes = strings.TrimSpace(text[i+len("#notes:"):])
+		text = text[:i]
+	}
+
+	hasItem := false
+	for _, param := range strings.Split(text, "&") {
+		key, value := param, ""
+		if i := strings.Index(param, "="); i >= 0 {
+			key, value = param[:i], param[i+1:]
+		}
+		switch key {
+		case "item":
+			item, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || item > 1<<32-1 || item < -(1<<32-1) {
+				return entry, fmt.Errorf("%q is not a valid item hash", value)
+			}
+			hasItem = true
+			switch {
+			case item == wishListWildcard:
+			case item < 0:
+				entry.Trash = true
+				entry.ItemHash = uint32(-item)
+			default:
+				entry.ItemHash = uint32(item)
+			}
+		case "perks":
+			for _, perk := range strings.Split(value, ",") {
+				if perk = strings.TrimSpace(perk); perk == "" {
+					continue
+				}
+				hash, err := strconv.ParseUint(perk, 10, 32)
+				if err != nil {
+					return entry, fmt.Errorf("%q is not a valid perk hash", perk)
+				}
+				entry.PerkHashes = append(entry.PerkHashes, uint32(hash))
+			}
+		}
+	}
+	if !hasItem {
+		return entry, fmt.Errorf("entry has no item")
+	}
+	return entry, nil
+}
+
+// Match returns the entries that match an item with plugs inserted, in wish list order.
+// An entry matches if it is for the item, or for any item, and every one of its perks is among plugHashes.
+func (w WishList) Match(itemHash uint32, plugHashes []uint32) []WishListEntry {
+	plugs := map[uint32]bool{}
+	for _, hash := range plugHashes {
+		plugs[hash] = true
+	}
+
+	var matches []WishListEntry
+	for _, entry := range w.Entries {
+		if entry.ItemHash != 0 && entry.ItemHash != itemHash {
+			continue
+		}
+		matched := true
+		for _, perk := range entry.PerkHashes {
+			if !plugs[perk] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, entry)
+		}
+	}
+	return matches
+}
+
+// WishListProblem classifies an issue with a wish list entry.
+type WishListProblem int
+
+const (
+	// UnknownItem means an entry's item isn't in the manifest.
+	UnknownItem WishListProblem = iota
+	// UnknownPerk means one of an entry's perks isn't in the manifest.
+	UnknownPerk
+	// PerkNotOnItem means one of an entry's perks isn't in any of its item's perk columns.
+	PerkNotOnItem
+	// RetiredPerk means one of an entry's perks can no longer be rolled on its item.
+	RetiredPerk
+)
+
+func (p WishListProblem) String() string {
+	switch p {
+	case UnknownItem:
+		return "unknown item"
+	case UnknownPerk:
+		return "unknown perk"
+	case PerkNotOnItem:
+		return "perk not on item"
+	case RetiredPerk:
+		return "retired perk"
+	}
+	return "unknown problem"
+}
+
+// WishListIssue is a problem with a hash in a wish list entry.
+type WishListIssue struct {
+	// Entry is the entry with the problem.
+	Entry WishListEntry
+	// Problem classifies what is wrong.
+	Problem WishListProblem
+	// Hash is the hash of the item or perk with the problem.
+	Hash uint32
+}
+
+func (i WishListIssue) String() string {
+	return fmt.Sprintf("line %d: %s %d", i.Entry.Line, i.Problem, i.Hash)
+}
+
+// Validate checks every item and perk hash in the wish list against the items and plug sets of e,
+// returning the issues in wish list order. Perks of entries that match any item are only checked to exist.
+func (w WishList) Validate(e RollEnumerator) ([]WishListIssue, error) {
+	// Retired perks must be in the columns to be told apart from perks that were never on the item.
+	e.CurrentOnly = false
+
+	var issues []WishListIssue
+	for _, entry := range w.Entries {
+		item, knownItem := e.Items[entry.ItemHash]
+		knownItem = knownItem && entry.ItemHash != 0
+		if entry.ItemHash != 0 && !knownItem {
+			issues = append(issues, WishListIssue{entry, UnknownItem, entry.ItemHash})
+		}
+
+		var plugs map[uint32]RollPlug
+		if knownItem {
+			columns, err := e.Columns(item)
+			if err != nil {
+				return nil, err
+			}
+			plugs = map[uint32]RollPlug{}
+			for _, column := range columns {
+				for _, plug := range column.Plugs {
+					if existing, ok := plugs[plug.PlugItemHash]; ok && existing.CurrentlyCanRoll {
+						continue
+					}
+					plugs[plug.PlugItemHash] = plug
+				}
+			}
+		}
+
+		for _, perk := range entry.PerkHashes {
+			if _, ok := e.Items[perk]; !ok {
+				issues = append(issues, WishListIssue{entry, UnknownPerk, perk})
+				continue
+			}
+			if plugs == nil {
+				continue
+			}
+			plug, ok := plugs[perk]
+			switch {
+			case !ok:
+				issues = append(issues, WishListIssue{entry, PerkNotOnItem, perk})
+			case !plug.CurrentlyCanRoll:
+				issues = append(issues, WishListIssue{entry, RetiredPerk, perk})
+			}
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,117 @@
+package destiny2
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testWishList = `title: Test Wish List
+description: Rolls for testing
+
+// Ace of Spades is always wanted.
+dimwishlist:item=1&perks=#notes:Always
+//notes: PvE rolls
+dimwishlist:item=2&perks=10,20
+dimwishlist:item=2&perks=11,21#notes:Overridden notes
+
+dimwishlist:item=-2&perks=11,22
+dimwishlist:item=-69420&perks=20
+dimwishlist:item=3&perks=99,21
+`
+
+func TestParseWishList(t *testing.T) {
+	list, err := ParseWishList(strings.NewReader(testWishList))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &WishList{
+		Title:       "Test Wish List",
+		Description: "Rolls for testing",
+		Entries: []WishListEntry{
+			{Line: 5, ItemHash: 1, Notes: "Always"},
+			{Line: 7, ItemHash: 2, PerkHashes: []uint32{10, 20}, Notes: "PvE rolls"},
+			{Line: 8, ItemHash: 2, PerkHashes: []uint32{11, 21}, Notes: "Overridden notes"},
+			{Line: 10, ItemHash: 2, PerkHashes: []uint32{11, 22}, Trash: true},
+			{Line: 11, PerkHashes: []uint32{20}},
+			{Line: 12, ItemHash: 3, PerkHashes: []uint32{99, 21}},
+		},
+	}
+	if diff := cmp.Diff(want, list); diff != "" {
+		t.Errorf("ParseWishList() mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, bad := range []string{"dimwishlist:item=ace", "dimwishlist:perks=1", "dimwishlist:item=1&perks=1,x"} {
+		if _, err := ParseWishList(strings.NewReader(bad)); !errors.As(err, &WishListError{}) {
+			t.Errorf("ParseWishList(%q): got error %v, want WishListError", bad, err)
+		}
+	}
+}
+
+func TestWishListMatch(t *testing.T) {
+	list, err := ParseWishList(strings.NewReader(testWishList))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		itemHash uint32
+		plugs    []uint32
+		want     []int
+	}{
+		{1, nil, []int{5}},
+		{2, []uint32{10, 20, 30}, []int{7, 11}},
+		{2, []uint32{11, 22}, []int{10}},
+		{2, []uint32{10, 21}, nil},
+		{4, []uint32{20}, []int{11}},
+	}
+	for _, test := range tests {
+		var got []int
+		for _, entry := range list.Match(test.itemHash, test.plugs) {
+			got = append(got, entry.Line)
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("Match(%d, %v) lines mismatch (-want +got):\n%s", test.itemHash, test.plugs, diff)
+		}
+	}
+}
+
+func TestWishListValidate(t *testing.T) {
+	list, err := ParseWishList(strings.NewReader(testWishList))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := RollEnumerator{
+		Items: InventoryItemDefinition{
+			1:  {},
+			2:  {Sockets: ItemSocketBlock{SocketEntries: []ItemSocketEntry{{RandomizedPlugSetHash: 1}, {RandomizedPlugSetHash: 2}}}},
+			10: {}, 11: {}, 20: {}, 21: {}, 22: {},
+		},
+		PlugSets: PlugSetDefinition{
+			1: {ReusablePlugItems: []ItemSocketEntryPlugItemRandomized{{PlugItemHash: 10, CurrentlyCanRoll: true}, {PlugItemHash: 11, CurrentlyCanRoll: false}}},
+			2: {ReusablePlugItems: []ItemSocketEntryPlugItemRandomized{{PlugItemHash: 20, CurrentlyCanRoll: true}, {PlugItemHash: 22, CurrentlyCanRoll: true}}},
+		},
+		CurrentOnly: true,
+	}
+
+	issues, err := list.Validate(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	want := []string{
+		"line 8: retired perk 11",
+		"line 8: perk not on item 21",
+		"line 10: retired perk 11",
+		"line 12: unknown item 3",
+		"line 12: unknown perk 99",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Validate() mismatch (-want +got):\n%s", diff)
+	}
+}